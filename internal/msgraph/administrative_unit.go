@@ -0,0 +1,99 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) CreateAdministrativeUnit(unit AdministrativeUnit) (*AdministrativeUnit, error) {
+	created := &AdministrativeUnit{}
+	err := c.doRequest(http.MethodPost, "/directory/administrativeUnits", unit, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetAdministrativeUnit(unitID string) (*AdministrativeUnit, error) {
+	unit := &AdministrativeUnit{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/directory/administrativeUnits/%s", unitID), nil, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return unit, nil
+}
+
+// UpdateAdministrativeUnit patches the properties of an administrative unit
+// that can change after it was created.
+func (c *Client) UpdateAdministrativeUnit(unit AdministrativeUnit) error {
+	payload := map[string]interface{}{
+		"displayName":                   unit.DisplayName,
+		"description":                   unit.Description,
+		"membershipRule":                unit.MembershipRule,
+		"membershipRuleProcessingState": unit.MembershipRuleProcessingState,
+	}
+	if unit.Visibility != nil {
+		payload["visibility"] = unit.Visibility
+	}
+	if unit.MembershipType != nil {
+		payload["membershipType"] = unit.MembershipType
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/directory/administrativeUnits/%s", unit.ID), payload, nil)
+}
+
+func (c *Client) DeleteAdministrativeUnit(unitID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/directory/administrativeUnits/%s", unitID), nil, nil)
+}
+
+func (c *Client) ListAdministrativeUnitMembers(unitID string) ([]DirectoryObject, error) {
+	return c.listDirectoryObjects(fmt.Sprintf("/directory/administrativeUnits/%s/members?$select=id,displayName", unitID))
+}
+
+func (c *Client) CheckAdministrativeUnitMember(unitID string, memberID string) (bool, error) {
+	members, err := c.ListAdministrativeUnitMembers(unitID)
+	if err != nil {
+		return false, err
+	}
+
+	for i := 0; i < len(members); i++ {
+		if members[i].ID == memberID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *Client) AddAdministrativeUnitMember(unitID string, memberID string) error {
+	return c.doRequest(http.MethodPost, fmt.Sprintf("/directory/administrativeUnits/%s/members/$ref", unitID), c.directoryObjectReference(memberID), nil)
+}
+
+func (c *Client) RemoveAdministrativeUnitMember(unitID string, memberID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/directory/administrativeUnits/%s/members/%s/$ref", unitID, memberID), nil, nil)
+}
+
+func (c *Client) CreateScopedRoleMembership(unitID string, membership ScopedRoleMembership) (*ScopedRoleMembership, error) {
+	created := &ScopedRoleMembership{}
+	err := c.doRequest(http.MethodPost, fmt.Sprintf("/directory/administrativeUnits/%s/scopedRoleMembers", unitID), membership, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetScopedRoleMembership(unitID string, membershipID string) (*ScopedRoleMembership, error) {
+	membership := &ScopedRoleMembership{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/directory/administrativeUnits/%s/scopedRoleMembers/%s", unitID, membershipID), nil, membership)
+	if err != nil {
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+func (c *Client) DeleteScopedRoleMembership(unitID string, membershipID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/directory/administrativeUnits/%s/scopedRoleMembers/%s", unitID, membershipID), nil, nil)
+}
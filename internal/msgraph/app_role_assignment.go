@@ -0,0 +1,50 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DefaultAppRoleID is used to assign a principal to a resource that does
+// not publish any app roles.
+const DefaultAppRoleID = "00000000-0000-0000-0000-000000000000"
+
+// GetAppRoleIDByValue resolves an app role value, e.g. Application.Read.All,
+// to its ID using the app roles published by the resource service principal.
+func (c *Client) GetAppRoleIDByValue(resourceID string, value string) (string, error) {
+	servicePrincipal, err := c.GetServicePrincipal(resourceID)
+	if err != nil {
+		return "", err
+	}
+
+	for i := 0; i < len(servicePrincipal.AppRoles); i++ {
+		if servicePrincipal.AppRoles[i].Value == value {
+			return servicePrincipal.AppRoles[i].ID, nil
+		}
+	}
+	return "", fmt.Errorf("app role %q is not published by service principal %s", value, resourceID)
+}
+
+func (c *Client) CreateAppRoleAssignment(assignment AppRoleAssignment) (*AppRoleAssignment, error) {
+	created := &AppRoleAssignment{}
+	err := c.doRequest(http.MethodPost, fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo", assignment.ResourceID), assignment, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetAppRoleAssignment(resourceID string, assignmentID string) (*AppRoleAssignment, error) {
+	assignment := &AppRoleAssignment{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo/%s", resourceID, assignmentID), nil, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (c *Client) DeleteAppRoleAssignment(resourceID string, assignmentID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo/%s", resourceID, assignmentID), nil, nil)
+}
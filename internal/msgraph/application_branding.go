@@ -0,0 +1,44 @@
+package msgraph
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+)
+
+// MaxApplicationLogoSize is the largest logo, in bytes, accepted by the
+// graph api.
+const MaxApplicationLogoSize = 100 * 1024
+
+func (c *Client) GetApplicationInfo(applicationID string) (*Application, error) {
+	application := &Application{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/applications/%s?$select=id,appId,displayName,info", applicationID), nil, application)
+	if err != nil {
+		return nil, err
+	}
+
+	if application.Info == nil {
+		application.Info = &InformationalURL{}
+	}
+
+	return application, nil
+}
+
+func (c *Client) PatchApplicationInfo(applicationID string, info InformationalURL) error {
+	// The logo url is read only, the logo is set through PutApplicationLogo.
+	info.LogoURL = ""
+
+	payload := map[string]interface{}{
+		"info": info,
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/applications/%s", applicationID), payload, nil)
+}
+
+func (c *Client) PutApplicationLogo(applicationID string, contentType string, logo []byte) error {
+	if len(logo) > MaxApplicationLogoSize {
+		return fmt.Errorf("logo is %d bytes, the maximum is %d", len(logo), MaxApplicationLogoSize)
+	}
+
+	return c.send(http.MethodPut, fmt.Sprintf("/applications/%s/logo", applicationID), map[string]string{"Content-Type": contentType}, bytes.NewReader(logo), nil)
+}
@@ -0,0 +1,41 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetApplicationOptionalClaims(applicationID string) (*Application, error) {
+	application := &Application{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/applications/%s?$select=id,appId,displayName,groupMembershipClaims,optionalClaims", applicationID), nil, application)
+	if err != nil {
+		return nil, err
+	}
+
+	if application.OptionalClaims == nil {
+		application.OptionalClaims = &OptionalClaims{}
+	}
+
+	return application, nil
+}
+
+// PatchApplicationOptionalClaims replaces the optional claims of an
+// application. A nil groupMembershipClaims clears the groups claim.
+func (c *Client) PatchApplicationOptionalClaims(applicationID string, optionalClaims OptionalClaims, groupMembershipClaims *string) error {
+	if optionalClaims.IDToken == nil {
+		optionalClaims.IDToken = make([]OptionalClaim, 0)
+	}
+	if optionalClaims.AccessToken == nil {
+		optionalClaims.AccessToken = make([]OptionalClaim, 0)
+	}
+	if optionalClaims.Saml2Token == nil {
+		optionalClaims.Saml2Token = make([]OptionalClaim, 0)
+	}
+
+	payload := map[string]interface{}{
+		"optionalClaims":        optionalClaims,
+		"groupMembershipClaims": groupMembershipClaims,
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/applications/%s", applicationID), payload, nil)
+}
@@ -0,0 +1,32 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) ListApplicationOwners(applicationID string) ([]DirectoryObject, error) {
+	return c.listDirectoryObjects(fmt.Sprintf("/applications/%s/owners?$select=id,displayName", applicationID))
+}
+
+func (c *Client) CheckApplicationOwner(applicationID string, ownerID string) (bool, error) {
+	owners, err := c.ListApplicationOwners(applicationID)
+	if err != nil {
+		return false, err
+	}
+
+	for i := 0; i < len(owners); i++ {
+		if owners[i].ID == ownerID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *Client) AddApplicationOwner(applicationID string, ownerID string) error {
+	return c.doRequest(http.MethodPost, fmt.Sprintf("/applications/%s/owners/$ref", applicationID), c.directoryObjectReference(ownerID), nil)
+}
+
+func (c *Client) RemoveApplicationOwner(applicationID string, ownerID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/applications/%s/owners/%s/$ref", applicationID, ownerID), nil, nil)
+}
@@ -0,0 +1,41 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// IDs and discriminators of the authentication method configurations.
+const (
+	AuthenticationMethodFido2                  = "Fido2"
+	AuthenticationMethodMicrosoftAuthenticator = "MicrosoftAuthenticator"
+	AuthenticationMethodTemporaryAccessPass    = "TemporaryAccessPass"
+
+	AuthenticationMethodTypeFido2                  = "#microsoft.graph.fido2AuthenticationMethodConfiguration"
+	AuthenticationMethodTypeMicrosoftAuthenticator = "#microsoft.graph.microsoftAuthenticatorAuthenticationMethodConfiguration"
+	AuthenticationMethodTypeTemporaryAccessPass    = "#microsoft.graph.temporaryAccessPassAuthenticationMethodConfiguration"
+)
+
+// AuthenticationMethodTargetAllUsers is the target ID that includes every
+// user in an authentication method configuration.
+const AuthenticationMethodTargetAllUsers = "all_users"
+
+func (c *Client) GetAuthenticationMethodConfiguration(methodID string) (*AuthenticationMethodConfiguration, error) {
+	configuration := &AuthenticationMethodConfiguration{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/policies/authenticationMethodsPolicy/authenticationMethodConfigurations/%s", methodID), nil, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+// UpdateAuthenticationMethodConfiguration patches a method configuration,
+// the configurations exist in every tenant and cannot be created or deleted.
+// The graph api requires the @odata.type of the method in the payload.
+func (c *Client) UpdateAuthenticationMethodConfiguration(configuration AuthenticationMethodConfiguration) error {
+	methodID := configuration.ID
+	configuration.ID = ""
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/policies/authenticationMethodsPolicy/authenticationMethodConfigurations/%s", methodID), configuration, nil)
+}
@@ -0,0 +1,105 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// AuthenticationMethodModes are the methods that can be combined in the
+// allowed combinations of an authentication strength, e.g. password,sms.
+var AuthenticationMethodModes = []string{
+	"password",
+	"voice",
+	"hardwareOath",
+	"softwareOath",
+	"sms",
+	"fido2",
+	"windowsHelloForBusiness",
+	"microsoftAuthenticatorPush",
+	"deviceBasedPush",
+	"temporaryAccessPassOneTime",
+	"temporaryAccessPassMultiUse",
+	"email",
+	"x509CertificateSingleFactor",
+	"x509CertificateMultiFactor",
+	"federatedSingleFactor",
+	"federatedMultiFactor",
+}
+
+func (c *Client) ListAuthenticationStrengthPolicies() ([]AuthenticationStrengthPolicy, error) {
+	policies := make([]AuthenticationStrengthPolicy, 0)
+
+	path := "/policies/authenticationStrengthPolicies"
+	for path != "" {
+		page := &AuthenticationStrengthPolicies{}
+		err := c.doRequest(http.MethodGet, path, nil, page)
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, page.Value...)
+		path = page.Odata_nextLink
+	}
+
+	return policies, nil
+}
+
+func (c *Client) GetAuthenticationStrengthPolicy(policyID string) (*AuthenticationStrengthPolicy, error) {
+	policy := &AuthenticationStrengthPolicy{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/policies/authenticationStrengthPolicies/%s", policyID), nil, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// GetAuthenticationStrengthPolicyByDisplayName looks up built-in and custom
+// policies by display name, the collection does not support filtering.
+func (c *Client) GetAuthenticationStrengthPolicyByDisplayName(displayName string) (*AuthenticationStrengthPolicy, error) {
+	policies, err := c.ListAuthenticationStrengthPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(policies); i++ {
+		if policies[i].DisplayName == displayName {
+			return &policies[i], nil
+		}
+	}
+	return nil, &GraphError{StatusCode: http.StatusNotFound, Code: "Request_ResourceNotFound", Message: fmt.Sprintf("no authentication strength policy named %q found", displayName)}
+}
+
+func (c *Client) CreateAuthenticationStrengthPolicy(policy AuthenticationStrengthPolicy) (*AuthenticationStrengthPolicy, error) {
+	created := &AuthenticationStrengthPolicy{}
+	err := c.doRequest(http.MethodPost, "/policies/authenticationStrengthPolicies", policy, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateAuthenticationStrengthPolicy patches the name and description, the
+// allowed combinations can only be changed with
+// UpdateAuthenticationStrengthCombinations.
+func (c *Client) UpdateAuthenticationStrengthPolicy(policy AuthenticationStrengthPolicy) error {
+	payload := map[string]interface{}{
+		"displayName": policy.DisplayName,
+		"description": policy.Description,
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/policies/authenticationStrengthPolicies/%s", policy.ID), payload, nil)
+}
+
+func (c *Client) UpdateAuthenticationStrengthCombinations(policyID string, combinations []string) error {
+	payload := map[string]interface{}{
+		"allowedCombinations": combinations,
+	}
+
+	return c.doRequest(http.MethodPost, fmt.Sprintf("/policies/authenticationStrengthPolicies/%s/updateAllowedCombinations", policyID), payload, nil)
+}
+
+func (c *Client) DeleteAuthenticationStrengthPolicy(policyID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/policies/authenticationStrengthPolicies/%s", policyID), nil, nil)
+}
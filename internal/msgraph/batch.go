@@ -0,0 +1,60 @@
+package msgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// MaxBatchRequests is the maximum number of requests the graph api accepts
+// in a single json $batch request.
+const MaxBatchRequests = 20
+
+// doBatch sends up to MaxBatchRequests requests as one $batch request. The
+// request ids are assigned by position, the error of each failed request is
+// returned at the position of the request and is nil on success.
+func (c *Client) doBatch(requests []BatchRequest) ([]error, error) {
+	if len(requests) > MaxBatchRequests {
+		return nil, fmt.Errorf("got %d batch requests, at most %d are supported", len(requests), MaxBatchRequests)
+	}
+
+	for i := 0; i < len(requests); i++ {
+		requests[i].ID = strconv.Itoa(i)
+	}
+
+	responses := &BatchResponses{}
+	err := c.doRequest(http.MethodPost, "/$batch", BatchRequests{Requests: requests}, responses)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]error, len(requests))
+	answered := make([]bool, len(requests))
+	for _, response := range responses.Responses {
+		i, err := strconv.Atoi(response.ID)
+		if err != nil || i < 0 || i >= len(requests) {
+			return nil, fmt.Errorf("got unexpected batch response id %q", response.ID)
+		}
+		answered[i] = true
+
+		if response.Status >= 200 && response.Status <= 299 {
+			continue
+		}
+
+		errorResponse := struct {
+			Error GraphError `json:"error"`
+		}{}
+		_ = json.Unmarshal(response.Body, &errorResponse)
+		errorResponse.Error.StatusCode = response.Status
+		errs[i] = &errorResponse.Error
+	}
+
+	for i := 0; i < len(requests); i++ {
+		if !answered[i] {
+			return nil, fmt.Errorf("got no batch response for %s %s", requests[i].Method, requests[i].URL)
+		}
+	}
+
+	return errs, nil
+}
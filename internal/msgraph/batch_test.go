@@ -0,0 +1,170 @@
+package msgraph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newBatchTestClient returns a client for a test server answering $batch
+// requests with respond, the sizes of the received batches are recorded.
+func newBatchTestClient(t *testing.T, respond func(request BatchRequest) BatchResponse) (*Client, *[]int) {
+	t.Helper()
+
+	batches := make([]int, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1.0/$batch" {
+			t.Errorf("got unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		requests := BatchRequests{}
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+			t.Errorf("decoding batch request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		batches = append(batches, len(requests.Requests))
+
+		responses := BatchResponses{Responses: make([]BatchResponse, 0)}
+		for _, request := range requests.Requests {
+			response := respond(request)
+			response.ID = request.ID
+			responses.Responses = append(responses.Responses, response)
+		}
+		_ = json.NewEncoder(w).Encode(responses)
+	}))
+	t.Cleanup(server.Close)
+
+	return &Client{GraphHost: server.URL, HTTPClient: server.Client()}, &batches
+}
+
+func batchErrorBody(code string) json.RawMessage {
+	return json.RawMessage(fmt.Sprintf(`{"error":{"code":%q,"message":"failed"}}`, code))
+}
+
+func TestDoBatch(t *testing.T) {
+	client, _ := newBatchTestClient(t, func(request BatchRequest) BatchResponse {
+		switch request.URL {
+		case "/missing":
+			return BatchResponse{Status: http.StatusNotFound, Body: batchErrorBody("Request_ResourceNotFound")}
+		case "/forbidden":
+			return BatchResponse{Status: http.StatusForbidden, Body: batchErrorBody("Authorization_RequestDenied")}
+		default:
+			return BatchResponse{Status: http.StatusNoContent}
+		}
+	})
+
+	errs, err := client.doBatch([]BatchRequest{
+		{Method: http.MethodDelete, URL: "/ok"},
+		{Method: http.MethodDelete, URL: "/missing"},
+		{Method: http.MethodDelete, URL: "/forbidden"},
+		{Method: http.MethodDelete, URL: "/ok"},
+	})
+	if err != nil {
+		t.Fatalf("doBatch returned error: %s", err)
+	}
+
+	tests := []struct {
+		status int
+		code   string
+	}{
+		{},
+		{status: http.StatusNotFound, code: "Request_ResourceNotFound"},
+		{status: http.StatusForbidden, code: "Authorization_RequestDenied"},
+		{},
+	}
+	if len(errs) != len(tests) {
+		t.Fatalf("got %d errors, want %d", len(errs), len(tests))
+	}
+	for i, tt := range tests {
+		if tt.status == 0 {
+			if errs[i] != nil {
+				t.Errorf("request %d: got error %s, want nil", i, errs[i])
+			}
+			continue
+		}
+
+		var graphErr *GraphError
+		if !errors.As(errs[i], &graphErr) {
+			t.Errorf("request %d: got error %v, want a graph error", i, errs[i])
+			continue
+		}
+		if graphErr.StatusCode != tt.status || graphErr.Code != tt.code {
+			t.Errorf("request %d: got %d %s, want %d %s", i, graphErr.StatusCode, graphErr.Code, tt.status, tt.code)
+		}
+	}
+}
+
+func TestDoBatchTooManyRequests(t *testing.T) {
+	client, batches := newBatchTestClient(t, func(request BatchRequest) BatchResponse {
+		return BatchResponse{Status: http.StatusNoContent}
+	})
+
+	requests := make([]BatchRequest, MaxBatchRequests+1)
+	_, err := client.doBatch(requests)
+	if err == nil {
+		t.Fatal("doBatch returned no error")
+	}
+	if len(*batches) != 0 {
+		t.Errorf("got %d batches sent, want none", len(*batches))
+	}
+}
+
+func TestRemoveGroupMembers(t *testing.T) {
+	tests := []struct {
+		name    string
+		members int
+		status  map[string]int
+		batches []int
+		wantErr string
+	}{
+		{name: "single full batch", members: 20, batches: []int{20}},
+		{name: "one more than a batch", members: 21, batches: []int{20, 1}},
+		{name: "two full batches", members: 40, batches: []int{20, 20}},
+		{name: "missing members", members: 21, status: map[string]int{"member-3": http.StatusNotFound, "member-20": http.StatusNotFound}, batches: []int{20, 1}},
+		{name: "failed member", members: 40, status: map[string]int{"member-25": http.StatusForbidden}, batches: []int{20, 20}, wantErr: "removing member member-25"},
+		{name: "stops after failed batch", members: 40, status: map[string]int{"member-5": http.StatusBadRequest}, batches: []int{20}, wantErr: "removing member member-5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			removed := make([]string, 0)
+			client, batches := newBatchTestClient(t, func(request BatchRequest) BatchResponse {
+				if request.Method != http.MethodDelete || !strings.HasPrefix(request.URL, "/groups/group/members/") || !strings.HasSuffix(request.URL, "/$ref") {
+					t.Errorf("got unexpected batch request %s %s", request.Method, request.URL)
+				}
+				member := strings.TrimSuffix(strings.TrimPrefix(request.URL, "/groups/group/members/"), "/$ref")
+				if status, ok := tt.status[member]; ok {
+					return BatchResponse{Status: status, Body: batchErrorBody("Failed")}
+				}
+				removed = append(removed, member)
+				return BatchResponse{Status: http.StatusNoContent}
+			})
+
+			members := make([]string, 0)
+			for i := 0; i < tt.members; i++ {
+				members = append(members, fmt.Sprintf("member-%d", i))
+			}
+
+			err := client.RemoveGroupMembers("group", members)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("RemoveGroupMembers returned error: %s", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("RemoveGroupMembers returned error %v, want %q", err, tt.wantErr)
+			}
+			if fmt.Sprint(*batches) != fmt.Sprint(tt.batches) {
+				t.Errorf("got batches %v, want %v", *batches, tt.batches)
+			}
+			if tt.wantErr == "" && len(removed)+len(tt.status) != tt.members {
+				t.Errorf("got %d members removed, want %d", len(removed), tt.members-len(tt.status))
+			}
+		})
+	}
+}
@@ -1,8 +1,17 @@
 package msgraph
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // ClientConfiguration represents the vinyldns client configuration.
@@ -38,3 +47,117 @@ func NewClient(config ClientConfiguration) *Client {
 		GraphHost:    config.GraphHost,
 	}
 }
+
+// GraphError represents an unsuccessful response from the graph api.
+type GraphError struct {
+	StatusCode int
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e *GraphError) Error() string {
+	return fmt.Sprintf("graph api returned %d: %s %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound reports whether err is a graph api 404 response.
+func IsNotFound(err error) bool {
+	var graphErr *GraphError
+	return errors.As(err, &graphErr) && graphErr.StatusCode == http.StatusNotFound
+}
+
+// odataFilter quotes and escapes the string values of an OData $filter
+// expression, e.g. odataFilter("appId eq %s", appID).
+func odataFilter(format string, values ...string) string {
+	quoted := make([]interface{}, 0)
+	for i := 0; i < len(values); i++ {
+		quoted = append(quoted, "'"+strings.ReplaceAll(values[i], "'", "''")+"'")
+	}
+
+	return escapeQuery(fmt.Sprintf(format, quoted...))
+}
+
+// odataSearch quotes and escapes a $search clause, e.g. displayName:jane.
+// Values that already start with a double quote are sent as written.
+func odataSearch(value string) string {
+	if !strings.HasPrefix(value, "\"") {
+		value = "\"" + strings.ReplaceAll(strings.ReplaceAll(value, "\\", "\\\\"), "\"", "\\\"") + "\""
+	}
+
+	return escapeQuery(value)
+}
+
+// escapeQuery escapes a query option value, spaces are sent as %20.
+func escapeQuery(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+// doRequest sends a request to the graph api v1.0 endpoint. The body, when
+// not nil, is sent as json and a successful response is decoded into out.
+// Absolute urls, such as an @odata.nextLink, are requested as they are.
+func (c *Client) doRequest(method string, path string, body interface{}, out interface{}) error {
+	if body == nil {
+		return c.send(method, path, nil, nil, out)
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("got json marshal error %v", err)
+	}
+	tflog.Trace(context.Background(), fmt.Sprintf("payload %s", string(b)))
+
+	return c.send(method, path, map[string]string{"Content-Type": "application/json"}, bytes.NewReader(b), out)
+}
+
+// doAdvancedQuery reads a collection with ConsistencyLevel eventual, which the
+// graph api requires for $search, $count and advanced $filter queries.
+func (c *Client) doAdvancedQuery(path string, out interface{}) error {
+	return c.send(http.MethodGet, path, map[string]string{"ConsistencyLevel": "eventual"}, nil, out)
+}
+
+// send performs the http request for doRequest, payload is sent as is with
+// the given headers.
+func (c *Client) send(method string, path string, headers map[string]string, payload io.Reader, out interface{}) error {
+	url := path
+	if !strings.HasPrefix(path, "https://") && !strings.HasPrefix(path, "http://") {
+		url = fmt.Sprintf("%s/v1.0%s", c.GraphHost, path)
+	}
+
+	req, err := http.NewRequest(method, url, payload)
+	if err != nil {
+		return fmt.Errorf("got http error %v", err)
+	}
+	for key, value := range headers {
+		req.Header.Add(key, value)
+	}
+
+	auth_header := fmt.Sprintf("%s %s", c.TokenType, c.AccessToken)
+	req.Header.Add("Authorization", auth_header)
+	req.Header.Add("User-Agent", c.UserAgent)
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("got http error %v", err)
+	}
+	defer res.Body.Close()
+
+	tflog.Trace(context.Background(), fmt.Sprintf("%s %s: %s", method, url, res.Status))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		errorResponse := struct {
+			Error GraphError `json:"error"`
+		}{}
+		_ = json.NewDecoder(res.Body).Decode(&errorResponse)
+		errorResponse.Error.StatusCode = res.StatusCode
+		return &errorResponse.Error
+	}
+
+	if out == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	err = json.NewDecoder(res.Body).Decode(out)
+	if err != nil {
+		return fmt.Errorf("got json marshal error %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) CreateConditionalAccessPolicy(policy ConditionalAccessPolicy) (*ConditionalAccessPolicy, error) {
+	created := &ConditionalAccessPolicy{}
+	err := c.doRequest(http.MethodPost, "/identity/conditionalAccess/policies", policy, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetConditionalAccessPolicy(policyID string) (*ConditionalAccessPolicy, error) {
+	policy := &ConditionalAccessPolicy{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/identity/conditionalAccess/policies/%s", policyID), nil, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// UpdateConditionalAccessPolicy patches the whole policy, controls that are
+// nil are sent as null and removed from the policy.
+func (c *Client) UpdateConditionalAccessPolicy(policy ConditionalAccessPolicy) error {
+	policyID := policy.ID
+	policy.ID = ""
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/identity/conditionalAccess/policies/%s", policyID), policy, nil)
+}
+
+func (c *Client) DeleteConditionalAccessPolicy(policyID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/identity/conditionalAccess/policies/%s", policyID), nil, nil)
+}
@@ -0,0 +1,86 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) GetCrossTenantAccessPolicyDefault() (*CrossTenantAccessPolicyConfiguration, error) {
+	configuration := &CrossTenantAccessPolicyConfiguration{}
+	err := c.doRequest(http.MethodGet, "/policies/crossTenantAccessPolicy/default", nil, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+// UpdateCrossTenantAccessPolicyDefault patches the default configuration,
+// it exists in every tenant and cannot be created or deleted.
+func (c *Client) UpdateCrossTenantAccessPolicyDefault(configuration CrossTenantAccessPolicyConfiguration) error {
+	configuration.IsServiceDefault = nil
+
+	return c.doRequest(http.MethodPatch, "/policies/crossTenantAccessPolicy/default", configuration, nil)
+}
+
+// ResetCrossTenantAccessPolicyDefault restores the system defaults of the
+// default configuration.
+func (c *Client) ResetCrossTenantAccessPolicyDefault() error {
+	return c.doRequest(http.MethodPost, "/policies/crossTenantAccessPolicy/default/resetToSystemDefault", nil, nil)
+}
+
+func (c *Client) CreateCrossTenantAccessPolicyPartner(configuration CrossTenantAccessPolicyConfiguration) (*CrossTenantAccessPolicyConfiguration, error) {
+	created := &CrossTenantAccessPolicyConfiguration{}
+	err := c.doRequest(http.MethodPost, "/policies/crossTenantAccessPolicy/partners", configuration, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetCrossTenantAccessPolicyPartner(tenantID string) (*CrossTenantAccessPolicyConfiguration, error) {
+	configuration := &CrossTenantAccessPolicyConfiguration{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantID), nil, configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return configuration, nil
+}
+
+// UpdateCrossTenantAccessPolicyPartner patches a partner configuration,
+// settings that are nil are reset to inherit the default configuration.
+func (c *Client) UpdateCrossTenantAccessPolicyPartner(configuration CrossTenantAccessPolicyConfiguration) error {
+	tenantID := configuration.TenantID
+	configuration.TenantID = ""
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantID), configuration, nil)
+}
+
+func (c *Client) DeleteCrossTenantAccessPolicyPartner(tenantID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantID), nil, nil)
+}
+
+func (c *Client) GetCrossTenantIdentitySyncPolicy(tenantID string) (*CrossTenantIdentitySyncPolicyPartner, error) {
+	policy := &CrossTenantIdentitySyncPolicyPartner{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s/identitySynchronization", tenantID), nil, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// SetCrossTenantIdentitySyncPolicy creates or replaces the identity
+// synchronization policy of a partner, the partner configuration must exist.
+func (c *Client) SetCrossTenantIdentitySyncPolicy(policy CrossTenantIdentitySyncPolicyPartner) error {
+	tenantID := policy.TenantID
+	policy.TenantID = ""
+
+	return c.doRequest(http.MethodPut, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s/identitySynchronization", tenantID), policy, nil)
+}
+
+func (c *Client) DeleteCrossTenantIdentitySyncPolicy(tenantID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s/identitySynchronization", tenantID), nil, nil)
+}
@@ -0,0 +1,139 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Statuses of a custom security attribute definition, definitions cannot be
+// deleted and are deprecated instead.
+const (
+	CustomSecurityAttributeStatusAvailable  = "Available"
+	CustomSecurityAttributeStatusDeprecated = "Deprecated"
+)
+
+// CustomSecurityAttributeValueType is the @odata.type of the attribute
+// values of an attribute set.
+const CustomSecurityAttributeValueType = "#Microsoft.DirectoryServices.CustomSecurityAttributeValue"
+
+func (c *Client) CreateAttributeSet(set AttributeSet) (*AttributeSet, error) {
+	created := &AttributeSet{}
+	err := c.doRequest(http.MethodPost, "/directory/attributeSets", set, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetAttributeSet(setID string) (*AttributeSet, error) {
+	set := &AttributeSet{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/directory/attributeSets/%s", setID), nil, set)
+	if err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+func (c *Client) UpdateAttributeSet(set AttributeSet) error {
+	setID := set.ID
+	set.ID = ""
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/directory/attributeSets/%s", setID), set, nil)
+}
+
+func (c *Client) CreateCustomSecurityAttributeDefinition(definition CustomSecurityAttributeDefinition) (*CustomSecurityAttributeDefinition, error) {
+	created := &CustomSecurityAttributeDefinition{}
+	err := c.doRequest(http.MethodPost, "/directory/customSecurityAttributeDefinitions", definition, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetCustomSecurityAttributeDefinition(definitionID string) (*CustomSecurityAttributeDefinition, error) {
+	definition := &CustomSecurityAttributeDefinition{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s", definitionID), nil, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+// UpdateCustomSecurityAttributeDefinition patches the properties of a
+// definition that can be changed after it was created.
+func (c *Client) UpdateCustomSecurityAttributeDefinition(definition CustomSecurityAttributeDefinition) error {
+	payload := map[string]interface{}{
+		"description":             definition.Description,
+		"status":                  definition.Status,
+		"usePreDefinedValuesOnly": definition.UsePreDefinedValuesOnly,
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s", definition.ID), payload, nil)
+}
+
+func (c *Client) ListAllowedValues(definitionID string) ([]AllowedValue, error) {
+	values := make([]AllowedValue, 0)
+
+	path := fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s/allowedValues", definitionID)
+	for path != "" {
+		page := &AllowedValues{}
+		err := c.doRequest(http.MethodGet, path, nil, page)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, page.Value...)
+		path = page.Odata_nextLink
+	}
+
+	return values, nil
+}
+
+func (c *Client) AddAllowedValue(definitionID string, value string) error {
+	return c.doRequest(http.MethodPost, fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s/allowedValues", definitionID), AllowedValue{ID: value, IsActive: true}, nil)
+}
+
+// SetAllowedValueActive activates or deactivates an allowed value, allowed
+// values cannot be deleted.
+func (c *Client) SetAllowedValueActive(definitionID string, value string, active bool) error {
+	payload := map[string]interface{}{
+		"isActive": active,
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s/allowedValues/%s", definitionID, value), payload, nil)
+}
+
+// GetCustomSecurityAttributes reads the attribute values of an object in
+// collection, either users or servicePrincipals.
+func (c *Client) GetCustomSecurityAttributes(collection string, objectID string) (*CustomSecurityAttributes, error) {
+	attributes := &CustomSecurityAttributes{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/%s/%s?$select=customSecurityAttributes", collection, objectID), nil, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
+// UpdateCustomSecurityAttributes patches the values of one attribute set,
+// values not present in the payload are left unchanged.
+func (c *Client) UpdateCustomSecurityAttributes(collection string, objectID string, attributeSet string, values map[string]interface{}) error {
+	set := map[string]interface{}{
+		"@odata.type": CustomSecurityAttributeValueType,
+	}
+	for name, value := range values {
+		set[name] = value
+	}
+
+	payload := map[string]interface{}{
+		"customSecurityAttributes": map[string]interface{}{
+			attributeSet: set,
+		},
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/%s/%s", collection, objectID), payload, nil)
+}
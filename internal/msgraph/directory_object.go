@@ -0,0 +1,52 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// listDirectoryObjects reads a collection of directory objects, following
+// @odata.nextLink until all pages have been read.
+func (c *Client) listDirectoryObjects(path string) ([]DirectoryObject, error) {
+	objects := make([]DirectoryObject, 0)
+
+	for path != "" {
+		page := &DirectoryObjects{}
+		err := c.doRequest(http.MethodGet, path, nil, page)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, page.Value...)
+		path = page.Odata_nextLink
+	}
+
+	return objects, nil
+}
+
+// GetDirectoryObject reads any directory object by ID, the @odata.type of
+// the result tells its type.
+func (c *Client) GetDirectoryObject(objectID string) (*DirectoryObject, error) {
+	object := &DirectoryObject{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/directoryObjects/%s", objectID), nil, object)
+	if err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// directoryObjectReference builds the @odata.id reference used when linking
+// a directory object to a relationship such as owners or members.
+func (c *Client) directoryObjectReference(objectID string) DirectoryObjectReference {
+	return DirectoryObjectReference{
+		Odata_id: fmt.Sprintf("%s/v1.0/directoryObjects/%s", c.GraphHost, objectID),
+	}
+}
+
+// Type returns the directory object type without the microsoft.graph
+// namespace, e.g. user or servicePrincipal.
+func (o DirectoryObject) Type() string {
+	return strings.TrimPrefix(o.Odata_type, "#microsoft.graph.")
+}
@@ -0,0 +1,109 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DirectoryScopeTenant is the directory scope of tenant wide role
+// assignments.
+const DirectoryScopeTenant = "/"
+
+// ListRoleDefinitions reads the built-in and custom directory role
+// definitions, following @odata.nextLink until all pages have been read.
+func (c *Client) ListRoleDefinitions() ([]UnifiedRoleDefinition, error) {
+	definitions := make([]UnifiedRoleDefinition, 0)
+
+	path := "/roleManagement/directory/roleDefinitions"
+	for path != "" {
+		page := &UnifiedRoleDefinitions{}
+		err := c.doRequest(http.MethodGet, path, nil, page)
+		if err != nil {
+			return nil, err
+		}
+
+		definitions = append(definitions, page.Value...)
+		path = page.Odata_nextLink
+	}
+
+	return definitions, nil
+}
+
+func (c *Client) GetRoleDefinitionByDisplayName(displayName string) (*UnifiedRoleDefinition, error) {
+	definitions := &UnifiedRoleDefinitions{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/roleManagement/directory/roleDefinitions?$filter=%s", odataFilter("displayName eq %s", displayName)), nil, definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(definitions.Value) == 0 {
+		return nil, &GraphError{StatusCode: http.StatusNotFound, Code: "Request_ResourceNotFound", Message: fmt.Sprintf("no role definition named %q found", displayName)}
+	}
+	if len(definitions.Value) > 1 {
+		return nil, fmt.Errorf("found %d role definitions named %q, expected exactly one", len(definitions.Value), displayName)
+	}
+
+	return &definitions.Value[0], nil
+}
+
+func (c *Client) CreateRoleAssignment(assignment UnifiedRoleAssignment) (*UnifiedRoleAssignment, error) {
+	created := &UnifiedRoleAssignment{}
+	err := c.doRequest(http.MethodPost, "/roleManagement/directory/roleAssignments", assignment, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetRoleAssignment(assignmentID string) (*UnifiedRoleAssignment, error) {
+	assignment := &UnifiedRoleAssignment{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/roleManagement/directory/roleAssignments/%s", assignmentID), nil, assignment)
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (c *Client) DeleteRoleAssignment(assignmentID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/roleManagement/directory/roleAssignments/%s", assignmentID), nil, nil)
+}
+
+func (c *Client) CreateRoleDefinition(definition UnifiedRoleDefinition) (*UnifiedRoleDefinition, error) {
+	created := &UnifiedRoleDefinition{}
+	err := c.doRequest(http.MethodPost, "/roleManagement/directory/roleDefinitions", definition, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetRoleDefinition(definitionID string) (*UnifiedRoleDefinition, error) {
+	definition := &UnifiedRoleDefinition{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/roleManagement/directory/roleDefinitions/%s", definitionID), nil, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+// UpdateRoleDefinition patches a custom role definition, the template ID
+// cannot change after creation.
+func (c *Client) UpdateRoleDefinition(definition UnifiedRoleDefinition) error {
+	payload := map[string]interface{}{
+		"displayName":     definition.DisplayName,
+		"description":     definition.Description,
+		"isEnabled":       definition.IsEnabled,
+		"rolePermissions": definition.RolePermissions,
+		"version":         definition.Version,
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/roleManagement/directory/roleDefinitions/%s", definition.ID), payload, nil)
+}
+
+func (c *Client) DeleteRoleDefinition(definitionID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/roleManagement/directory/roleDefinitions/%s", definitionID), nil, nil)
+}
@@ -0,0 +1,85 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Schema extension lifecycle states, a schema extension can only move
+// forward from InDevelopment to Available to Deprecated.
+const (
+	SchemaExtensionStatusInDevelopment = "InDevelopment"
+	SchemaExtensionStatusAvailable     = "Available"
+	SchemaExtensionStatusDeprecated    = "Deprecated"
+)
+
+// SchemaExtensionStatuses lists the lifecycle states in order.
+var SchemaExtensionStatuses = []string{
+	SchemaExtensionStatusInDevelopment,
+	SchemaExtensionStatusAvailable,
+	SchemaExtensionStatusDeprecated,
+}
+
+func (c *Client) CreateExtensionProperty(applicationID string, property ExtensionProperty) (*ExtensionProperty, error) {
+	created := &ExtensionProperty{}
+	err := c.doRequest(http.MethodPost, fmt.Sprintf("/applications/%s/extensionProperties", applicationID), property, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetExtensionProperty(applicationID string, propertyID string) (*ExtensionProperty, error) {
+	property := &ExtensionProperty{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/applications/%s/extensionProperties/%s", applicationID, propertyID), nil, property)
+	if err != nil {
+		return nil, err
+	}
+
+	return property, nil
+}
+
+func (c *Client) DeleteExtensionProperty(applicationID string, propertyID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/applications/%s/extensionProperties/%s", applicationID, propertyID), nil, nil)
+}
+
+// CreateSchemaExtension registers a schema extension, new schema extensions
+// are always InDevelopment.
+func (c *Client) CreateSchemaExtension(extension SchemaExtension) (*SchemaExtension, error) {
+	extension.Status = ""
+
+	created := &SchemaExtension{}
+	err := c.doRequest(http.MethodPost, "/schemaExtensions", extension, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetSchemaExtension(extensionID string) (*SchemaExtension, error) {
+	extension := &SchemaExtension{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/schemaExtensions/%s", extensionID), nil, extension)
+	if err != nil {
+		return nil, err
+	}
+
+	return extension, nil
+}
+
+// UpdateSchemaExtension patches a schema extension, the graph api requires
+// the owner in the payload. Properties and target types can only be added
+// once the extension is Available.
+func (c *Client) UpdateSchemaExtension(extension SchemaExtension) error {
+	extensionID := extension.ID
+	extension.ID = ""
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/schemaExtensions/%s", extensionID), extension, nil)
+}
+
+// DeleteSchemaExtension deletes a schema extension, only extensions that are
+// InDevelopment can be deleted.
+func (c *Client) DeleteSchemaExtension(extensionID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/schemaExtensions/%s", extensionID), nil, nil)
+}
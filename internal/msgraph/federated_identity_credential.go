@@ -0,0 +1,63 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// MaxFederatedIdentityCredentials is the number of federated identity
+// credentials the graph api allows on a single application.
+const MaxFederatedIdentityCredentials = 20
+
+func (c *Client) ListFederatedIdentityCredentials(applicationID string) ([]FederatedIdentityCredential, error) {
+	credentials := &FederatedIdentityCredentials{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/applications/%s/federatedIdentityCredentials", applicationID), nil, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.Value, nil
+}
+
+func (c *Client) GetFederatedIdentityCredential(applicationID string, credentialID string) (*FederatedIdentityCredential, error) {
+	credential := &FederatedIdentityCredential{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/applications/%s/federatedIdentityCredentials/%s", applicationID, credentialID), nil, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+func (c *Client) CreateFederatedIdentityCredential(applicationID string, credential FederatedIdentityCredential) (*FederatedIdentityCredential, error) {
+	existing, err := c.ListFederatedIdentityCredentials(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(existing) >= MaxFederatedIdentityCredentials {
+		return nil, fmt.Errorf("application %s already has %d federated identity credentials, the maximum is %d", applicationID, len(existing), MaxFederatedIdentityCredentials)
+	}
+
+	created := &FederatedIdentityCredential{}
+	err = c.doRequest(http.MethodPost, fmt.Sprintf("/applications/%s/federatedIdentityCredentials", applicationID), credential, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) UpdateFederatedIdentityCredential(applicationID string, credential FederatedIdentityCredential) error {
+	credentialID := credential.ID
+
+	// The name and id of a credential are immutable and rejected in a patch.
+	credential.ID = ""
+	credential.Name = ""
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/applications/%s/federatedIdentityCredentials/%s", applicationID, credentialID), credential, nil)
+}
+
+func (c *Client) DeleteFederatedIdentityCredential(applicationID string, credentialID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/applications/%s/federatedIdentityCredentials/%s", applicationID, credentialID), nil, nil)
+}
@@ -0,0 +1,68 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const groupSelect = "id,displayName,description,mailNickname,mailEnabled,securityEnabled,groupTypes,membershipRule,membershipRuleProcessingState,isAssignableToRole,visibility,mail"
+
+// CreateGroup creates a group, ownerIDs are bound as owners at creation time.
+func (c *Client) CreateGroup(group Group, ownerIDs []string) (*Group, error) {
+	for i := 0; i < len(ownerIDs); i++ {
+		group.OwnersBind = append(group.OwnersBind, c.directoryObjectReference(ownerIDs[i]).Odata_id)
+	}
+
+	created := &Group{}
+	err := c.doRequest(http.MethodPost, "/groups", group, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetGroup(groupID string) (*Group, error) {
+	group := &Group{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/groups/%s?$select=%s", groupID, groupSelect), nil, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// UpdateGroup patches the properties of a group that can change after it
+// was created.
+func (c *Client) UpdateGroup(group Group) error {
+	payload := map[string]interface{}{
+		"displayName":                   group.DisplayName,
+		"description":                   group.Description,
+		"mailNickname":                  group.MailNickname,
+		"securityEnabled":               group.SecurityEnabled,
+		"groupTypes":                    group.GroupTypes,
+		"membershipRule":                group.MembershipRule,
+		"membershipRuleProcessingState": group.MembershipRuleProcessingState,
+	}
+	if group.Visibility != nil {
+		payload["visibility"] = group.Visibility
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/groups/%s", group.ID), payload, nil)
+}
+
+func (c *Client) DeleteGroup(groupID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/groups/%s", groupID), nil, nil)
+}
+
+func (c *Client) ListGroupOwners(groupID string) ([]DirectoryObject, error) {
+	return c.listDirectoryObjects(fmt.Sprintf("/groups/%s/owners?$select=id,displayName", groupID))
+}
+
+func (c *Client) AddGroupOwner(groupID string, ownerID string) error {
+	return c.doRequest(http.MethodPost, fmt.Sprintf("/groups/%s/owners/$ref", groupID), c.directoryObjectReference(ownerID), nil)
+}
+
+func (c *Client) RemoveGroupOwner(groupID string, ownerID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/groups/%s/owners/%s/$ref", groupID, ownerID), nil, nil)
+}
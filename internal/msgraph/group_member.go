@@ -0,0 +1,96 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// MaxGroupMembersPerRequest is the maximum number of members the graph api
+// accepts in a single members@odata.bind request.
+const MaxGroupMembersPerRequest = 20
+
+func (c *Client) ListGroupMembers(groupID string) ([]DirectoryObject, error) {
+	return c.listDirectoryObjects(fmt.Sprintf("/groups/%s/members?$select=id,displayName&$top=999", groupID))
+}
+
+// CheckGroupMember looks up a single direct member with a filter instead of
+// reading every member of a possibly large group.
+func (c *Client) CheckGroupMember(groupID string, memberID string) (bool, error) {
+	members := &DirectoryObjects{}
+	err := c.doAdvancedQuery(fmt.Sprintf("/groups/%s/members?$count=true&$select=id&$filter=%s", groupID, odataFilter("id eq %s", memberID)), members)
+	if err != nil {
+		return false, err
+	}
+
+	return len(members.Value) > 0, nil
+}
+
+func (c *Client) AddGroupMember(groupID string, memberID string) error {
+	return c.doRequest(http.MethodPost, fmt.Sprintf("/groups/%s/members/$ref", groupID), c.directoryObjectReference(memberID), nil)
+}
+
+// AddGroupMembers adds members in batches of MaxGroupMembersPerRequest, the
+// members added by earlier batches are kept when a batch fails.
+func (c *Client) AddGroupMembers(groupID string, memberIDs []string) error {
+	for start := 0; start < len(memberIDs); start += MaxGroupMembersPerRequest {
+		end := start + MaxGroupMembersPerRequest
+		if end > len(memberIDs) {
+			end = len(memberIDs)
+		}
+
+		references := make([]string, 0)
+		for i := start; i < end; i++ {
+			references = append(references, c.directoryObjectReference(memberIDs[i]).Odata_id)
+		}
+
+		err := c.doRequest(http.MethodPatch, fmt.Sprintf("/groups/%s", groupID), map[string]interface{}{
+			"members@odata.bind": references,
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveGroupMembers removes members in $batch requests of MaxBatchRequests,
+// members that are not in the group are ignored. The members removed by
+// earlier batches stay removed when a batch fails.
+func (c *Client) RemoveGroupMembers(groupID string, memberIDs []string) error {
+	for start := 0; start < len(memberIDs); start += MaxBatchRequests {
+		end := start + MaxBatchRequests
+		if end > len(memberIDs) {
+			end = len(memberIDs)
+		}
+
+		requests := make([]BatchRequest, 0)
+		for i := start; i < end; i++ {
+			requests = append(requests, BatchRequest{
+				Method: http.MethodDelete,
+				URL:    fmt.Sprintf("/groups/%s/members/%s/$ref", groupID, memberIDs[i]),
+			})
+		}
+
+		errs, err := c.doBatch(requests)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(errs); i++ {
+			if errs[i] != nil && !IsNotFound(errs[i]) {
+				return fmt.Errorf("removing member %s: %w", memberIDs[start+i], errs[i])
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveGroupMember(groupID string, memberID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/groups/%s/members/%s/$ref", groupID, memberID), nil, nil)
+}
+
+// ListGroupTransitiveMembers reads the direct and nested members of a group.
+func (c *Client) ListGroupTransitiveMembers(groupID string) ([]DirectoryObject, error) {
+	return c.listDirectoryObjects(fmt.Sprintf("/groups/%s/transitiveMembers?$select=id,displayName&$top=999", groupID))
+}
@@ -0,0 +1,17 @@
+package msgraph
+
+import (
+	"net/http"
+)
+
+// CreateInvitation invites an external user, the guest user is created
+// immediately and the invitation itself cannot be read back.
+func (c *Client) CreateInvitation(invitation Invitation) (*Invitation, error) {
+	created := &Invitation{}
+	err := c.doRequest(http.MethodPost, "/invitations", invitation, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
@@ -1,6 +1,9 @@
 package msgraph
 
-import "net/http"
+import (
+	"encoding/json"
+	"net/http"
+)
 
 type Auth struct {
 	ClientID     string
@@ -28,10 +31,13 @@ type Applications struct {
 }
 
 type Application struct {
-	AppID       string         `json:"appId"`
-	DisplayName string         `json:"displayName"`
-	ID          string         `json:"id"`
-	Web         ApplicationWeb `json:"web"`
+	AppID                 string            `json:"appId"`
+	DisplayName           string            `json:"displayName"`
+	ID                    string            `json:"id"`
+	Web                   ApplicationWeb    `json:"web"`
+	GroupMembershipClaims string            `json:"groupMembershipClaims,omitempty"`
+	OptionalClaims        *OptionalClaims   `json:"optionalClaims,omitempty"`
+	Info                  *InformationalURL `json:"info,omitempty"`
 }
 
 type ApplicationWeb struct {
@@ -43,6 +49,27 @@ type ApplicationWeb struct {
 	RedirectUris []string `json:"redirectUris"`
 }
 
+type InformationalURL struct {
+	LogoURL             string  `json:"logoUrl,omitempty"`
+	MarketingURL        *string `json:"marketingUrl"`
+	PrivacyStatementURL *string `json:"privacyStatementUrl"`
+	SupportURL          *string `json:"supportUrl"`
+	TermsOfServiceURL   *string `json:"termsOfServiceUrl"`
+}
+
+type OptionalClaims struct {
+	IDToken     []OptionalClaim `json:"idToken"`
+	AccessToken []OptionalClaim `json:"accessToken"`
+	Saml2Token  []OptionalClaim `json:"saml2Token"`
+}
+
+type OptionalClaim struct {
+	Name                 string   `json:"name"`
+	Source               *string  `json:"source"`
+	Essential            bool     `json:"essential"`
+	AdditionalProperties []string `json:"additionalProperties"`
+}
+
 type RedirectURISettings struct {
 	Index interface{} `json:"index"`
 	URI   string      `json:"uri"`
@@ -61,3 +88,562 @@ type Client struct {
 	AuthHost     string
 	GraphHost    string
 }
+
+type FederatedIdentityCredentials struct {
+	Odata_context string                        `json:"@odata.context"`
+	Value         []FederatedIdentityCredential `json:"value"`
+}
+
+type FederatedIdentityCredential struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Issuer      string   `json:"issuer"`
+	Subject     string   `json:"subject"`
+	Description string   `json:"description"`
+	Audiences   []string `json:"audiences"`
+}
+
+type DirectoryObjects struct {
+	Odata_context  string            `json:"@odata.context"`
+	Odata_nextLink string            `json:"@odata.nextLink"`
+	Value          []DirectoryObject `json:"value"`
+}
+
+type DirectoryObject struct {
+	Odata_type  string `json:"@odata.type"`
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type DirectoryObjectReference struct {
+	Odata_id string `json:"@odata.id"`
+}
+
+// BatchRequests is the payload of a json $batch request, the url of each
+// request is relative to the api version.
+type BatchRequests struct {
+	Requests []BatchRequest `json:"requests"`
+}
+
+type BatchRequest struct {
+	ID     string `json:"id"`
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+type BatchResponses struct {
+	Responses []BatchResponse `json:"responses"`
+}
+
+type BatchResponse struct {
+	ID     string          `json:"id"`
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
+
+type ServicePrincipals struct {
+	Odata_context  string             `json:"@odata.context"`
+	Odata_nextLink string             `json:"@odata.nextLink"`
+	Value          []ServicePrincipal `json:"value"`
+}
+
+type ServicePrincipal struct {
+	ID                        string            `json:"id,omitempty"`
+	AppID                     string            `json:"appId,omitempty"`
+	DisplayName               string            `json:"displayName,omitempty"`
+	AppRoleAssignmentRequired bool              `json:"appRoleAssignmentRequired"`
+	Tags                      []string          `json:"tags"`
+	Notes                     *string           `json:"notes"`
+	PreferredSingleSignOnMode *string           `json:"preferredSingleSignOnMode"`
+	LoginURL                  *string           `json:"loginUrl"`
+	ServicePrincipalType      string            `json:"servicePrincipalType,omitempty"`
+	AppOwnerOrganizationID    string            `json:"appOwnerOrganizationId,omitempty"`
+	AppRoles                  []AppRole         `json:"appRoles,omitempty"`
+	OAuth2PermissionScopes    []PermissionScope `json:"oauth2PermissionScopes,omitempty"`
+}
+
+type AppRole struct {
+	ID                 string   `json:"id"`
+	Value              string   `json:"value"`
+	DisplayName        string   `json:"displayName"`
+	Description        string   `json:"description"`
+	AllowedMemberTypes []string `json:"allowedMemberTypes"`
+	IsEnabled          bool     `json:"isEnabled"`
+}
+
+type PermissionScope struct {
+	ID                      string `json:"id"`
+	Value                   string `json:"value"`
+	Type                    string `json:"type"`
+	AdminConsentDisplayName string `json:"adminConsentDisplayName"`
+	IsEnabled               bool   `json:"isEnabled"`
+}
+
+type AppRoleAssignment struct {
+	ID                   string `json:"id,omitempty"`
+	PrincipalID          string `json:"principalId"`
+	ResourceID           string `json:"resourceId"`
+	AppRoleID            string `json:"appRoleId"`
+	PrincipalDisplayName string `json:"principalDisplayName,omitempty"`
+	PrincipalType        string `json:"principalType,omitempty"`
+	ResourceDisplayName  string `json:"resourceDisplayName,omitempty"`
+}
+
+type OAuth2PermissionGrants struct {
+	Odata_context string                  `json:"@odata.context"`
+	Value         []OAuth2PermissionGrant `json:"value"`
+}
+
+type OAuth2PermissionGrant struct {
+	ID          string  `json:"id,omitempty"`
+	ClientID    string  `json:"clientId"`
+	ConsentType string  `json:"consentType"`
+	PrincipalID *string `json:"principalId"`
+	ResourceID  string  `json:"resourceId"`
+	Scope       string  `json:"scope"`
+}
+
+type Users struct {
+	Odata_context  string `json:"@odata.context"`
+	Odata_count    int64  `json:"@odata.count"`
+	Odata_nextLink string `json:"@odata.nextLink"`
+	Value          []User `json:"value"`
+}
+
+type User struct {
+	ID                string           `json:"id,omitempty"`
+	DisplayName       string           `json:"displayName"`
+	UserPrincipalName string           `json:"userPrincipalName"`
+	MailNickname      string           `json:"mailNickname"`
+	Mail              string           `json:"mail,omitempty"`
+	AccountEnabled    bool             `json:"accountEnabled"`
+	UserType          string           `json:"userType,omitempty"`
+	GivenName         *string          `json:"givenName"`
+	Surname           *string          `json:"surname"`
+	UsageLocation     *string          `json:"usageLocation"`
+	Department        *string          `json:"department"`
+	JobTitle          *string          `json:"jobTitle"`
+	CompanyName       *string          `json:"companyName"`
+	OfficeLocation    *string          `json:"officeLocation"`
+	MobilePhone       *string          `json:"mobilePhone"`
+	EmployeeID        *string          `json:"employeeId"`
+	PasswordProfile   *PasswordProfile `json:"passwordProfile,omitempty"`
+}
+
+type PasswordProfile struct {
+	Password                      string `json:"password"`
+	ForceChangePasswordNextSignIn bool   `json:"forceChangePasswordNextSignIn"`
+}
+
+// CollectionQuery holds the OData query options of a collection read.
+type CollectionQuery struct {
+	Filter     string
+	Search     string
+	Select     []string
+	MaxResults int
+}
+
+type Groups struct {
+	Odata_context  string  `json:"@odata.context"`
+	Odata_nextLink string  `json:"@odata.nextLink"`
+	Value          []Group `json:"value"`
+}
+
+type Group struct {
+	ID                            string   `json:"id,omitempty"`
+	DisplayName                   string   `json:"displayName"`
+	Description                   *string  `json:"description"`
+	MailNickname                  string   `json:"mailNickname"`
+	MailEnabled                   bool     `json:"mailEnabled"`
+	SecurityEnabled               bool     `json:"securityEnabled"`
+	GroupTypes                    []string `json:"groupTypes"`
+	MembershipRule                *string  `json:"membershipRule"`
+	MembershipRuleProcessingState *string  `json:"membershipRuleProcessingState"`
+	IsAssignableToRole            *bool    `json:"isAssignableToRole,omitempty"`
+	Visibility                    *string  `json:"visibility,omitempty"`
+	Mail                          string   `json:"mail,omitempty"`
+	OwnersBind                    []string `json:"owners@odata.bind,omitempty"`
+}
+
+type UnifiedRoleDefinitions struct {
+	Odata_context  string                  `json:"@odata.context"`
+	Odata_nextLink string                  `json:"@odata.nextLink"`
+	Value          []UnifiedRoleDefinition `json:"value"`
+}
+
+type UnifiedRoleDefinition struct {
+	ID              string                  `json:"id,omitempty"`
+	DisplayName     string                  `json:"displayName"`
+	Description     *string                 `json:"description"`
+	IsBuiltIn       bool                    `json:"isBuiltIn,omitempty"`
+	IsEnabled       bool                    `json:"isEnabled"`
+	TemplateID      string                  `json:"templateId,omitempty"`
+	Version         *string                 `json:"version"`
+	RolePermissions []UnifiedRolePermission `json:"rolePermissions"`
+}
+
+type UnifiedRolePermission struct {
+	AllowedResourceActions []string `json:"allowedResourceActions"`
+}
+
+type UnifiedRoleAssignment struct {
+	ID               string `json:"id,omitempty"`
+	PrincipalID      string `json:"principalId"`
+	RoleDefinitionID string `json:"roleDefinitionId"`
+	DirectoryScopeID string `json:"directoryScopeId"`
+}
+
+type UnifiedRoleEligibilityScheduleRequest struct {
+	ID               string           `json:"id,omitempty"`
+	Action           string           `json:"action"`
+	PrincipalID      string           `json:"principalId"`
+	RoleDefinitionID string           `json:"roleDefinitionId"`
+	DirectoryScopeID string           `json:"directoryScopeId"`
+	Justification    *string          `json:"justification,omitempty"`
+	ScheduleInfo     *RequestSchedule `json:"scheduleInfo,omitempty"`
+	Status           string           `json:"status,omitempty"`
+}
+
+type RequestSchedule struct {
+	StartDateTime *string            `json:"startDateTime,omitempty"`
+	Expiration    *ExpirationPattern `json:"expiration,omitempty"`
+}
+
+type ExpirationPattern struct {
+	Type        string  `json:"type"`
+	Duration    *string `json:"duration,omitempty"`
+	EndDateTime *string `json:"endDateTime,omitempty"`
+}
+
+type UnifiedRoleEligibilitySchedules struct {
+	Odata_context  string                           `json:"@odata.context"`
+	Odata_nextLink string                           `json:"@odata.nextLink"`
+	Value          []UnifiedRoleEligibilitySchedule `json:"value"`
+}
+
+type UnifiedRoleEligibilitySchedule struct {
+	ID               string           `json:"id"`
+	PrincipalID      string           `json:"principalId"`
+	RoleDefinitionID string           `json:"roleDefinitionId"`
+	DirectoryScopeID string           `json:"directoryScopeId"`
+	Status           string           `json:"status"`
+	ScheduleInfo     *RequestSchedule `json:"scheduleInfo"`
+}
+
+type AdministrativeUnit struct {
+	ID                            string  `json:"id,omitempty"`
+	DisplayName                   string  `json:"displayName"`
+	Description                   *string `json:"description"`
+	Visibility                    *string `json:"visibility,omitempty"`
+	IsMemberManagementRestricted  *bool   `json:"isMemberManagementRestricted,omitempty"`
+	MembershipType                *string `json:"membershipType,omitempty"`
+	MembershipRule                *string `json:"membershipRule,omitempty"`
+	MembershipRuleProcessingState *string `json:"membershipRuleProcessingState,omitempty"`
+}
+
+type ScopedRoleMembership struct {
+	ID                   string   `json:"id,omitempty"`
+	AdministrativeUnitID string   `json:"administrativeUnitId,omitempty"`
+	RoleID               string   `json:"roleId"`
+	RoleMemberInfo       Identity `json:"roleMemberInfo"`
+}
+
+type Identity struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+type ConditionalAccessPolicy struct {
+	ID              string                            `json:"id,omitempty"`
+	DisplayName     string                            `json:"displayName"`
+	State           string                            `json:"state"`
+	Conditions      ConditionalAccessConditionSet     `json:"conditions"`
+	GrantControls   *ConditionalAccessGrantControls   `json:"grantControls"`
+	SessionControls *ConditionalAccessSessionControls `json:"sessionControls"`
+}
+
+type ConditionalAccessConditionSet struct {
+	Users            ConditionalAccessUsers        `json:"users"`
+	Applications     ConditionalAccessApplications `json:"applications"`
+	Platforms        *ConditionalAccessPlatforms   `json:"platforms"`
+	Locations        *ConditionalAccessLocations   `json:"locations"`
+	ClientAppTypes   []string                      `json:"clientAppTypes"`
+	SignInRiskLevels []string                      `json:"signInRiskLevels"`
+	UserRiskLevels   []string                      `json:"userRiskLevels"`
+}
+
+type ConditionalAccessUsers struct {
+	IncludeUsers  []string `json:"includeUsers"`
+	ExcludeUsers  []string `json:"excludeUsers"`
+	IncludeGroups []string `json:"includeGroups"`
+	ExcludeGroups []string `json:"excludeGroups"`
+	IncludeRoles  []string `json:"includeRoles"`
+	ExcludeRoles  []string `json:"excludeRoles"`
+}
+
+type ConditionalAccessApplications struct {
+	IncludeApplications []string `json:"includeApplications"`
+	ExcludeApplications []string `json:"excludeApplications"`
+	IncludeUserActions  []string `json:"includeUserActions"`
+}
+
+type ConditionalAccessPlatforms struct {
+	IncludePlatforms []string `json:"includePlatforms"`
+	ExcludePlatforms []string `json:"excludePlatforms"`
+}
+
+type ConditionalAccessLocations struct {
+	IncludeLocations []string `json:"includeLocations"`
+	ExcludeLocations []string `json:"excludeLocations"`
+}
+
+type ConditionalAccessGrantControls struct {
+	Operator                    string                  `json:"operator"`
+	BuiltInControls             []string                `json:"builtInControls"`
+	CustomAuthenticationFactors []string                `json:"customAuthenticationFactors"`
+	TermsOfUse                  []string                `json:"termsOfUse"`
+	AuthenticationStrength      *AuthenticationStrength `json:"authenticationStrength"`
+}
+
+type AuthenticationStrength struct {
+	ID string `json:"id"`
+}
+
+type ConditionalAccessSessionControls struct {
+	ApplicationEnforcedRestrictions *SessionControl                  `json:"applicationEnforcedRestrictions"`
+	CloudAppSecurity                *CloudAppSecuritySessionControl  `json:"cloudAppSecurity"`
+	SignInFrequency                 *SignInFrequencySessionControl   `json:"signInFrequency"`
+	PersistentBrowser               *PersistentBrowserSessionControl `json:"persistentBrowser"`
+	DisableResilienceDefaults       *bool                            `json:"disableResilienceDefaults"`
+}
+
+type SessionControl struct {
+	IsEnabled bool `json:"isEnabled"`
+}
+
+type CloudAppSecuritySessionControl struct {
+	IsEnabled            bool   `json:"isEnabled"`
+	CloudAppSecurityType string `json:"cloudAppSecurityType"`
+}
+
+type SignInFrequencySessionControl struct {
+	IsEnabled         bool    `json:"isEnabled"`
+	Type              *string `json:"type"`
+	Value             *int64  `json:"value"`
+	FrequencyInterval string  `json:"frequencyInterval"`
+}
+
+type PersistentBrowserSessionControl struct {
+	IsEnabled bool   `json:"isEnabled"`
+	Mode      string `json:"mode"`
+}
+
+type NamedLocation struct {
+	Odata_type                        string    `json:"@odata.type"`
+	ID                                string    `json:"id,omitempty"`
+	DisplayName                       string    `json:"displayName"`
+	IsTrusted                         *bool     `json:"isTrusted,omitempty"`
+	IPRanges                          []IPRange `json:"ipRanges,omitempty"`
+	CountriesAndRegions               []string  `json:"countriesAndRegions,omitempty"`
+	IncludeUnknownCountriesAndRegions *bool     `json:"includeUnknownCountriesAndRegions,omitempty"`
+}
+
+type IPRange struct {
+	Odata_type  string `json:"@odata.type"`
+	CidrAddress string `json:"cidrAddress"`
+}
+
+type AuthenticationStrengthPolicies struct {
+	Odata_context  string                         `json:"@odata.context"`
+	Odata_nextLink string                         `json:"@odata.nextLink"`
+	Value          []AuthenticationStrengthPolicy `json:"value"`
+}
+
+type AuthenticationStrengthPolicy struct {
+	ID                    string   `json:"id,omitempty"`
+	DisplayName           string   `json:"displayName"`
+	Description           *string  `json:"description"`
+	PolicyType            string   `json:"policyType,omitempty"`
+	RequirementsSatisfied string   `json:"requirementsSatisfied,omitempty"`
+	AllowedCombinations   []string `json:"allowedCombinations"`
+}
+
+type AuthenticationMethodConfiguration struct {
+	Odata_type     string                       `json:"@odata.type"`
+	ID             string                       `json:"id,omitempty"`
+	State          string                       `json:"state"`
+	IncludeTargets []AuthenticationMethodTarget `json:"includeTargets"`
+	ExcludeTargets []ExcludeTarget              `json:"excludeTargets"`
+
+	// fido2AuthenticationMethodConfiguration
+	IsSelfServiceRegistrationAllowed *bool                 `json:"isSelfServiceRegistrationAllowed,omitempty"`
+	IsAttestationEnforced            *bool                 `json:"isAttestationEnforced,omitempty"`
+	KeyRestrictions                  *Fido2KeyRestrictions `json:"keyRestrictions,omitempty"`
+
+	// microsoftAuthenticatorAuthenticationMethodConfiguration
+	IsSoftwareOathEnabled *bool `json:"isSoftwareOathEnabled,omitempty"`
+
+	// temporaryAccessPassAuthenticationMethodConfiguration
+	DefaultLifetimeInMinutes *int64 `json:"defaultLifetimeInMinutes,omitempty"`
+	DefaultLength            *int64 `json:"defaultLength,omitempty"`
+	MinimumLifetimeInMinutes *int64 `json:"minimumLifetimeInMinutes,omitempty"`
+	MaximumLifetimeInMinutes *int64 `json:"maximumLifetimeInMinutes,omitempty"`
+	IsUsableOnce             *bool  `json:"isUsableOnce,omitempty"`
+}
+
+type AuthenticationMethodTarget struct {
+	TargetType             string `json:"targetType"`
+	ID                     string `json:"id"`
+	IsRegistrationRequired bool   `json:"isRegistrationRequired"`
+	AuthenticationMode     string `json:"authenticationMode,omitempty"`
+}
+
+type ExcludeTarget struct {
+	TargetType string `json:"targetType"`
+	ID         string `json:"id"`
+}
+
+type Fido2KeyRestrictions struct {
+	IsEnforced      bool     `json:"isEnforced"`
+	EnforcementType string   `json:"enforcementType"`
+	AaGuids         []string `json:"aaGuids"`
+}
+
+// StsPolicy is a claims mapping, token lifetime or home realm discovery
+// policy, the definition holds a single JSON document.
+type StsPolicy struct {
+	ID                    string   `json:"id,omitempty"`
+	DisplayName           string   `json:"displayName"`
+	Definition            []string `json:"definition"`
+	IsOrganizationDefault *bool    `json:"isOrganizationDefault,omitempty"`
+}
+
+type ExtensionProperty struct {
+	ID             string   `json:"id,omitempty"`
+	Name           string   `json:"name"`
+	DataType       string   `json:"dataType"`
+	TargetObjects  []string `json:"targetObjects"`
+	IsMultiValued  bool     `json:"isMultiValued"`
+	AppDisplayName string   `json:"appDisplayName,omitempty"`
+}
+
+type SchemaExtension struct {
+	ID          string                    `json:"id,omitempty"`
+	Description string                    `json:"description"`
+	TargetTypes []string                  `json:"targetTypes"`
+	Properties  []ExtensionSchemaProperty `json:"properties"`
+	Status      string                    `json:"status,omitempty"`
+	Owner       string                    `json:"owner"`
+}
+
+type ExtensionSchemaProperty struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type AttributeSet struct {
+	ID                  string  `json:"id,omitempty"`
+	Description         *string `json:"description"`
+	MaxAttributesPerSet *int64  `json:"maxAttributesPerSet,omitempty"`
+}
+
+type CustomSecurityAttributeDefinition struct {
+	ID                      string `json:"id,omitempty"`
+	AttributeSet            string `json:"attributeSet"`
+	Name                    string `json:"name"`
+	Description             string `json:"description,omitempty"`
+	Type                    string `json:"type"`
+	Status                  string `json:"status"`
+	IsCollection            bool   `json:"isCollection"`
+	IsSearchable            bool   `json:"isSearchable"`
+	UsePreDefinedValuesOnly bool   `json:"usePreDefinedValuesOnly"`
+}
+
+type AllowedValues struct {
+	Odata_context  string         `json:"@odata.context"`
+	Odata_nextLink string         `json:"@odata.nextLink"`
+	Value          []AllowedValue `json:"value"`
+}
+
+type AllowedValue struct {
+	ID       string `json:"id"`
+	IsActive bool   `json:"isActive"`
+}
+
+// CustomSecurityAttributes holds the attribute values of a user or service
+// principal keyed by attribute set, the values of a set are keyed by
+// attribute name next to their @odata.type annotations.
+type CustomSecurityAttributes struct {
+	CustomSecurityAttributes map[string]map[string]interface{} `json:"customSecurityAttributes"`
+}
+
+type Invitation struct {
+	ID                      string                  `json:"id,omitempty"`
+	InvitedUserEmailAddress string                  `json:"invitedUserEmailAddress"`
+	InvitedUserDisplayName  *string                 `json:"invitedUserDisplayName,omitempty"`
+	InvitedUserType         *string                 `json:"invitedUserType,omitempty"`
+	InviteRedirectURL       string                  `json:"inviteRedirectUrl"`
+	InviteRedeemURL         string                  `json:"inviteRedeemUrl,omitempty"`
+	SendInvitationMessage   bool                    `json:"sendInvitationMessage"`
+	InvitedUserMessageInfo  *InvitedUserMessageInfo `json:"invitedUserMessageInfo,omitempty"`
+	InvitedUser             *DirectoryObject        `json:"invitedUser,omitempty"`
+	Status                  string                  `json:"status,omitempty"`
+}
+
+type InvitedUserMessageInfo struct {
+	CustomizedMessageBody *string     `json:"customizedMessageBody,omitempty"`
+	MessageLanguage       *string     `json:"messageLanguage,omitempty"`
+	CcRecipients          []Recipient `json:"ccRecipients,omitempty"`
+}
+
+type Recipient struct {
+	EmailAddress EmailAddress `json:"emailAddress"`
+}
+
+type EmailAddress struct {
+	Address string `json:"address"`
+}
+
+// CrossTenantAccessPolicyConfiguration is either the default configuration
+// or the configuration of a partner tenant. Settings of a partner that are
+// null are inherited from the default configuration.
+type CrossTenantAccessPolicyConfiguration struct {
+	TenantID                 string                               `json:"tenantId,omitempty"`
+	IsServiceDefault         *bool                                `json:"isServiceDefault,omitempty"`
+	InboundTrust             *CrossTenantAccessPolicyInboundTrust `json:"inboundTrust"`
+	B2BCollaborationInbound  *CrossTenantAccessPolicyB2BSetting   `json:"b2bCollaborationInbound"`
+	B2BCollaborationOutbound *CrossTenantAccessPolicyB2BSetting   `json:"b2bCollaborationOutbound"`
+	B2BDirectConnectInbound  *CrossTenantAccessPolicyB2BSetting   `json:"b2bDirectConnectInbound"`
+	B2BDirectConnectOutbound *CrossTenantAccessPolicyB2BSetting   `json:"b2bDirectConnectOutbound"`
+}
+
+type CrossTenantAccessPolicyInboundTrust struct {
+	IsMfaAccepted                       *bool `json:"isMfaAccepted"`
+	IsCompliantDeviceAccepted           *bool `json:"isCompliantDeviceAccepted"`
+	IsHybridAzureADJoinedDeviceAccepted *bool `json:"isHybridAzureADJoinedDeviceAccepted"`
+}
+
+type CrossTenantAccessPolicyB2BSetting struct {
+	UsersAndGroups *CrossTenantAccessPolicyTargetConfiguration `json:"usersAndGroups"`
+	Applications   *CrossTenantAccessPolicyTargetConfiguration `json:"applications"`
+}
+
+type CrossTenantAccessPolicyTargetConfiguration struct {
+	AccessType string                          `json:"accessType"`
+	Targets    []CrossTenantAccessPolicyTarget `json:"targets"`
+}
+
+type CrossTenantAccessPolicyTarget struct {
+	Target     string `json:"target"`
+	TargetType string `json:"targetType"`
+}
+
+type CrossTenantIdentitySyncPolicyPartner struct {
+	TenantID        string                      `json:"tenantId,omitempty"`
+	DisplayName     *string                     `json:"displayName,omitempty"`
+	UserSyncInbound *CrossTenantUserSyncInbound `json:"userSyncInbound"`
+}
+
+type CrossTenantUserSyncInbound struct {
+	IsSyncAllowed bool `json:"isSyncAllowed"`
+}
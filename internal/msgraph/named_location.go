@@ -0,0 +1,48 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Discriminators of the named location and IP range types.
+const (
+	NamedLocationTypeIP      = "#microsoft.graph.ipNamedLocation"
+	NamedLocationTypeCountry = "#microsoft.graph.countryNamedLocation"
+
+	IPRangeTypeV4 = "#microsoft.graph.iPv4CidrRange"
+	IPRangeTypeV6 = "#microsoft.graph.iPv6CidrRange"
+)
+
+func (c *Client) CreateNamedLocation(location NamedLocation) (*NamedLocation, error) {
+	created := &NamedLocation{}
+	err := c.doRequest(http.MethodPost, "/identity/conditionalAccess/namedLocations", location, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetNamedLocation(locationID string) (*NamedLocation, error) {
+	location := &NamedLocation{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/identity/conditionalAccess/namedLocations/%s", locationID), nil, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+// UpdateNamedLocation patches a named location, the graph api requires the
+// @odata.type of the location in the payload.
+func (c *Client) UpdateNamedLocation(location NamedLocation) error {
+	locationID := location.ID
+	location.ID = ""
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/identity/conditionalAccess/namedLocations/%s", locationID), location, nil)
+}
+
+func (c *Client) DeleteNamedLocation(locationID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/identity/conditionalAccess/namedLocations/%s", locationID), nil, nil)
+}
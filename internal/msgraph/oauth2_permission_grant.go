@@ -0,0 +1,107 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) GetOAuth2PermissionGrant(grantID string) (*OAuth2PermissionGrant, error) {
+	grant := &OAuth2PermissionGrant{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/oauth2PermissionGrants/%s", grantID), nil, grant)
+	if err != nil {
+		return nil, err
+	}
+
+	return grant, nil
+}
+
+// FindOAuth2PermissionGrant returns the grant of a client to a resource for
+// the consent type and principal, principalID is empty for AllPrincipals.
+func (c *Client) FindOAuth2PermissionGrant(clientID string, resourceID string, consentType string, principalID string) (*OAuth2PermissionGrant, error) {
+	grants := &OAuth2PermissionGrants{}
+	filter := odataFilter("clientId eq %s and resourceId eq %s and consentType eq %s", clientID, resourceID, consentType)
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/oauth2PermissionGrants?$filter=%s", filter), nil, grants)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(grants.Value); i++ {
+		grantPrincipalID := ""
+		if grants.Value[i].PrincipalID != nil {
+			grantPrincipalID = *grants.Value[i].PrincipalID
+		}
+		if grantPrincipalID == principalID {
+			return &grants.Value[i], nil
+		}
+	}
+
+	return nil, &GraphError{StatusCode: http.StatusNotFound, Code: "Request_ResourceNotFound", Message: "no oauth2 permission grant found"}
+}
+
+func (c *Client) CreateOAuth2PermissionGrant(grant OAuth2PermissionGrant) (*OAuth2PermissionGrant, error) {
+	created := &OAuth2PermissionGrant{}
+	err := c.doRequest(http.MethodPost, "/oauth2PermissionGrants", grant, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) UpdateOAuth2PermissionGrantScope(grantID string, scope string) error {
+	payload := map[string]interface{}{
+		"scope": scope,
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/oauth2PermissionGrants/%s", grantID), payload, nil)
+}
+
+func (c *Client) DeleteOAuth2PermissionGrant(grantID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/oauth2PermissionGrants/%s", grantID), nil, nil)
+}
+
+// SplitScopes splits the space separated scope of a grant.
+func SplitScopes(scope string) []string {
+	return strings.Fields(scope)
+}
+
+// MergeScopes adds scopes to the space separated scope of a grant, keeping
+// the existing scopes and their order.
+func MergeScopes(scope string, scopes []string) string {
+	merged := SplitScopes(scope)
+	for i := 0; i < len(scopes); i++ {
+		found := false
+		for j := 0; j < len(merged); j++ {
+			if merged[j] == scopes[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, scopes[i])
+		}
+	}
+
+	return strings.Join(merged, " ")
+}
+
+// RemoveScopes removes scopes from the space separated scope of a grant.
+func RemoveScopes(scope string, scopes []string) string {
+	remaining := make([]string, 0)
+	current := SplitScopes(scope)
+	for i := 0; i < len(current); i++ {
+		found := false
+		for j := 0; j < len(scopes); j++ {
+			if current[i] == scopes[j] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			remaining = append(remaining, current[i])
+		}
+	}
+
+	return strings.Join(remaining, " ")
+}
@@ -0,0 +1,51 @@
+package msgraph
+
+import "testing"
+
+func TestMergeScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		scope  string
+		scopes []string
+		want   string
+	}{
+		{name: "empty grant", scope: "", scopes: []string{"User.Read", "Mail.Read"}, want: "User.Read Mail.Read"},
+		{name: "keeps existing order", scope: "Mail.Read User.Read", scopes: []string{"Files.Read", "User.Read"}, want: "Mail.Read User.Read Files.Read"},
+		{name: "existing scope", scope: "User.Read", scopes: []string{"User.Read"}, want: "User.Read"},
+		{name: "duplicate scopes", scope: "", scopes: []string{"User.Read", "User.Read"}, want: "User.Read"},
+		{name: "extra whitespace", scope: " User.Read  Mail.Read ", scopes: nil, want: "User.Read Mail.Read"},
+		{name: "case sensitive", scope: "User.Read", scopes: []string{"user.read"}, want: "User.Read user.read"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeScopes(tt.scope, tt.scopes); got != tt.want {
+				t.Errorf("MergeScopes(%q, %q) = %q, want %q", tt.scope, tt.scopes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		scope  string
+		scopes []string
+		want   string
+	}{
+		{name: "keeps remaining order", scope: "Mail.Read User.Read Files.Read", scopes: []string{"User.Read"}, want: "Mail.Read Files.Read"},
+		{name: "all scopes", scope: "User.Read Mail.Read", scopes: []string{"Mail.Read", "User.Read"}, want: ""},
+		{name: "missing scope", scope: "User.Read", scopes: []string{"Mail.Read"}, want: "User.Read"},
+		{name: "duplicate grant scope", scope: "User.Read User.Read Mail.Read", scopes: []string{"User.Read"}, want: "Mail.Read"},
+		{name: "empty grant", scope: "", scopes: []string{"User.Read"}, want: ""},
+		{name: "nothing removed", scope: " User.Read  Mail.Read ", scopes: nil, want: "User.Read Mail.Read"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RemoveScopes(tt.scope, tt.scopes); got != tt.want {
+				t.Errorf("RemoveScopes(%q, %q) = %q, want %q", tt.scope, tt.scopes, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	ScheduleRequestActionAdminAssign = "adminAssign"
+	ScheduleRequestActionAdminRemove = "adminRemove"
+
+	ExpirationTypeAfterDuration = "afterDuration"
+	ExpirationTypeAfterDateTime = "afterDateTime"
+	ExpirationTypeNoExpiration  = "noExpiration"
+)
+
+func (c *Client) CreateRoleEligibilityScheduleRequest(request UnifiedRoleEligibilityScheduleRequest) (*UnifiedRoleEligibilityScheduleRequest, error) {
+	created := &UnifiedRoleEligibilityScheduleRequest{}
+	err := c.doRequest(http.MethodPost, "/roleManagement/directory/roleEligibilityScheduleRequests", request, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetRoleEligibilityScheduleRequest(requestID string) (*UnifiedRoleEligibilityScheduleRequest, error) {
+	request := &UnifiedRoleEligibilityScheduleRequest{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/roleManagement/directory/roleEligibilityScheduleRequests/%s", requestID), nil, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// FindRoleEligibilitySchedule returns the eligibility schedule of a principal
+// for a role and directory scope, which is what a schedule request results in.
+func (c *Client) FindRoleEligibilitySchedule(principalID string, roleDefinitionID string, directoryScopeID string) (*UnifiedRoleEligibilitySchedule, error) {
+	filter := odataFilter("principalId eq %s and roleDefinitionId eq %s and directoryScopeId eq %s", principalID, roleDefinitionID, directoryScopeID)
+
+	schedules := &UnifiedRoleEligibilitySchedules{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/roleManagement/directory/roleEligibilitySchedules?$filter=%s", filter), nil, schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(schedules.Value) == 0 {
+		return nil, &GraphError{StatusCode: http.StatusNotFound, Code: "Request_ResourceNotFound", Message: "no role eligibility schedule found"}
+	}
+
+	return &schedules.Value[0], nil
+}
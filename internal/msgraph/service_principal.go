@@ -0,0 +1,81 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// WellKnownApplicationIDs maps first-party Microsoft applications to the
+// application IDs their service principals are found by in every tenant.
+var WellKnownApplicationIDs = map[string]string{
+	"AzureActiveDirectoryGraph": "00000002-0000-0000-c000-000000000000",
+	"AzureKeyVault":             "cfa8b339-82a2-471a-a3c9-0fc0be7a4093",
+	"AzureServiceManagement":    "797f4846-ba00-4fd7-ba43-dac1f8f63013",
+	"AzureStorage":              "e406a681-f3d4-42a8-90b6-c2b029497af1",
+	"MicrosoftGraph":            "00000003-0000-0000-c000-000000000000",
+	"Office365ExchangeOnline":   "00000002-0000-0ff1-ce00-000000000000",
+	"Office365SharePointOnline": "00000003-0000-0ff1-ce00-000000000000",
+	"PowerBIService":            "00000009-0000-0000-c000-000000000000",
+}
+
+func (c *Client) CreateServicePrincipal(servicePrincipal ServicePrincipal) (*ServicePrincipal, error) {
+	created := &ServicePrincipal{}
+	err := c.doRequest(http.MethodPost, "/servicePrincipals", servicePrincipal, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetServicePrincipal(servicePrincipalID string) (*ServicePrincipal, error) {
+	servicePrincipal := &ServicePrincipal{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/servicePrincipals/%s", servicePrincipalID), nil, servicePrincipal)
+	if err != nil {
+		return nil, err
+	}
+
+	return servicePrincipal, nil
+}
+
+func (c *Client) GetServicePrincipalByAppID(appID string) (*ServicePrincipal, error) {
+	return c.findServicePrincipal(odataFilter("appId eq %s", appID))
+}
+
+func (c *Client) GetServicePrincipalByDisplayName(displayName string) (*ServicePrincipal, error) {
+	return c.findServicePrincipal(odataFilter("displayName eq %s", displayName))
+}
+
+// findServicePrincipal returns the single service principal matching filter.
+func (c *Client) findServicePrincipal(filter string) (*ServicePrincipal, error) {
+	servicePrincipals := &ServicePrincipals{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/servicePrincipals?$filter=%s", filter), nil, servicePrincipals)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(servicePrincipals.Value) == 0 {
+		return nil, &GraphError{StatusCode: http.StatusNotFound, Code: "Request_ResourceNotFound", Message: "no service principal found"}
+	}
+	if len(servicePrincipals.Value) > 1 {
+		return nil, fmt.Errorf("found %d service principals, expected exactly one", len(servicePrincipals.Value))
+	}
+
+	return &servicePrincipals.Value[0], nil
+}
+
+func (c *Client) UpdateServicePrincipal(servicePrincipal ServicePrincipal) error {
+	payload := map[string]interface{}{
+		"appRoleAssignmentRequired": servicePrincipal.AppRoleAssignmentRequired,
+		"tags":                      servicePrincipal.Tags,
+		"notes":                     servicePrincipal.Notes,
+		"preferredSingleSignOnMode": servicePrincipal.PreferredSingleSignOnMode,
+		"loginUrl":                  servicePrincipal.LoginURL,
+	}
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/servicePrincipals/%s", servicePrincipal.ID), payload, nil)
+}
+
+func (c *Client) DeleteServicePrincipal(servicePrincipalID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/servicePrincipals/%s", servicePrincipalID), nil, nil)
+}
@@ -0,0 +1,79 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// StsPolicyKind is the collection of an sts policy below /policies, it is
+// also the name of the service principal relationship.
+type StsPolicyKind string
+
+const (
+	StsPolicyKindClaimsMapping      StsPolicyKind = "claimsMappingPolicies"
+	StsPolicyKindTokenLifetime      StsPolicyKind = "tokenLifetimePolicies"
+	StsPolicyKindHomeRealmDiscovery StsPolicyKind = "homeRealmDiscoveryPolicies"
+)
+
+func (c *Client) CreateStsPolicy(kind StsPolicyKind, policy StsPolicy) (*StsPolicy, error) {
+	created := &StsPolicy{}
+	err := c.doRequest(http.MethodPost, fmt.Sprintf("/policies/%s", kind), policy, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetStsPolicy(kind StsPolicyKind, policyID string) (*StsPolicy, error) {
+	policy := &StsPolicy{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/policies/%s/%s", kind, policyID), nil, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (c *Client) UpdateStsPolicy(kind StsPolicyKind, policy StsPolicy) error {
+	policyID := policy.ID
+	policy.ID = ""
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/policies/%s/%s", kind, policyID), policy, nil)
+}
+
+func (c *Client) DeleteStsPolicy(kind StsPolicyKind, policyID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/policies/%s/%s", kind, policyID), nil, nil)
+}
+
+func (c *Client) ListServicePrincipalStsPolicies(kind StsPolicyKind, servicePrincipalID string) ([]DirectoryObject, error) {
+	return c.listDirectoryObjects(fmt.Sprintf("/servicePrincipals/%s/%s?$select=id,displayName", servicePrincipalID, kind))
+}
+
+func (c *Client) CheckServicePrincipalStsPolicy(kind StsPolicyKind, servicePrincipalID string, policyID string) (bool, error) {
+	policies, err := c.ListServicePrincipalStsPolicies(kind, servicePrincipalID)
+	if err != nil {
+		return false, err
+	}
+
+	for i := 0; i < len(policies); i++ {
+		if policies[i].ID == policyID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AssignServicePrincipalStsPolicy links a policy to a service principal, a
+// service principal can only have one policy of each kind.
+func (c *Client) AssignServicePrincipalStsPolicy(kind StsPolicyKind, servicePrincipalID string, policyID string) error {
+	reference := DirectoryObjectReference{
+		Odata_id: fmt.Sprintf("%s/v1.0/policies/%s/%s", c.GraphHost, kind, policyID),
+	}
+
+	return c.doRequest(http.MethodPost, fmt.Sprintf("/servicePrincipals/%s/%s/$ref", servicePrincipalID, kind), reference, nil)
+}
+
+func (c *Client) RemoveServicePrincipalStsPolicy(kind StsPolicyKind, servicePrincipalID string, policyID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/servicePrincipals/%s/%s/%s/$ref", servicePrincipalID, kind, policyID), nil, nil)
+}
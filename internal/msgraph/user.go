@@ -0,0 +1,110 @@
+package msgraph
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// userSelect lists the user properties read by the provider, most of them
+// are not returned by the graph api unless selected.
+const userSelect = "id,displayName,userPrincipalName,mailNickname,mail,accountEnabled,userType,givenName,surname,usageLocation,department,jobTitle,companyName,officeLocation,mobilePhone,employeeId"
+
+func (c *Client) CreateUser(user User) (*User, error) {
+	created := &User{}
+	err := c.doRequest(http.MethodPost, "/users", user, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// GetUser reads a user by object ID or user principal name.
+func (c *Client) GetUser(userID string) (*User, error) {
+	user := &User{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/users/%s?$select=%s", url.PathEscape(userID), userSelect), nil, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (c *Client) GetUserByMail(mail string) (*User, error) {
+	users := &Users{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("/users?$filter=%s&$select=%s", odataFilter("mail eq %s", mail), userSelect), nil, users)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users.Value) == 0 {
+		return nil, &GraphError{StatusCode: http.StatusNotFound, Code: "Request_ResourceNotFound", Message: "no user found"}
+	}
+	if len(users.Value) > 1 {
+		return nil, fmt.Errorf("found %d users with mail %s, expected exactly one", len(users.Value), mail)
+	}
+
+	return &users.Value[0], nil
+}
+
+// UpdateUser patches a user, the password profile is never sent.
+func (c *Client) UpdateUser(user User) error {
+	userID := user.ID
+	user.ID = ""
+	user.Mail = ""
+	user.UserType = ""
+	user.PasswordProfile = nil
+
+	return c.doRequest(http.MethodPatch, fmt.Sprintf("/users/%s", userID), user, nil)
+}
+
+func (c *Client) DeleteUser(userID string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("/users/%s", userID), nil, nil)
+}
+
+// ListUsers reads the users matching query, following @odata.nextLink until
+// all pages or query.MaxResults users have been read.
+func (c *Client) ListUsers(query CollectionQuery) ([]User, error) {
+	selected := userSelect
+	if len(query.Select) > 0 {
+		selected = strings.Join(append([]string{"id", "userPrincipalName"}, query.Select...), ",")
+	}
+
+	top := 999
+	if query.MaxResults > 0 && query.MaxResults < top {
+		top = query.MaxResults
+	}
+
+	path := fmt.Sprintf("/users?$count=true&$top=%d&$select=%s", top, escapeQuery(selected))
+	if query.Filter != "" {
+		path += fmt.Sprintf("&$filter=%s", escapeQuery(query.Filter))
+	}
+	if query.Search != "" {
+		path += fmt.Sprintf("&$search=%s", odataSearch(query.Search))
+	}
+
+	users := make([]User, 0)
+	for path != "" {
+		page := &Users{}
+		err := c.doAdvancedQuery(path, page)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, page.Value...)
+		if query.MaxResults > 0 && len(users) >= query.MaxResults {
+			return users[:query.MaxResults], nil
+		}
+		path = page.Odata_nextLink
+	}
+
+	return users, nil
+}
+
+// ListUserTransitiveMemberOf reads the groups, directory roles and
+// administrative units a user is a direct or nested member of.
+func (c *Client) ListUserTransitiveMemberOf(userID string) ([]DirectoryObject, error) {
+	return c.listDirectoryObjects(fmt.Sprintf("/users/%s/transitiveMemberOf?$select=id,displayName&$top=999", url.PathEscape(userID)))
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AdministrativeUnitMemberResource{}
+var _ resource.ResourceWithImportState = &AdministrativeUnitMemberResource{}
+
+func NewAdministrativeUnitMemberResource() resource.Resource {
+	return &AdministrativeUnitMemberResource{}
+}
+
+// AdministrativeUnitMemberResource defines the resource implementation.
+type AdministrativeUnitMemberResource struct {
+	client *msgraph.Client
+}
+
+// AdministrativeUnitMemberResourceModel describes the resource data model.
+type AdministrativeUnitMemberResourceModel struct {
+	Id                         types.String `tfsdk:"id"`
+	AdministrativeUnitObjectID types.String `tfsdk:"administrative_unit_object_id"`
+	MemberObjectID             types.String `tfsdk:"member_object_id"`
+}
+
+func (r *AdministrativeUnitMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_administrative_unit_member"
+}
+
+func (r *AdministrativeUnitMemberResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Administrative unit member resource, adds a user, group or device to an administrative unit with assigned membership",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier in the form `administrativeUnitObjectId/memberObjectId`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"administrative_unit_object_id": {
+				MarkdownDescription: "Administrative Unit Object ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"member_object_id": {
+				MarkdownDescription: "Object ID of the member, a user, group or device",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *AdministrativeUnitMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AdministrativeUnitMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AdministrativeUnitMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	present, err := r.client.CheckAdministrativeUnitMember(data.AdministrativeUnitObjectID.Value, data.MemberObjectID.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read administrative unit members, got error: %s", err))
+		return
+	}
+
+	if present {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Member %s is already present in Administrative Unit %s, import it instead", data.MemberObjectID.Value, data.AdministrativeUnitObjectID.Value))
+		return
+	}
+
+	err = r.client.AddAdministrativeUnitMember(data.AdministrativeUnitObjectID.Value, data.MemberObjectID.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add administrative unit member, got error: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf("%s/%s", data.AdministrativeUnitObjectID.Value, data.MemberObjectID.Value)}
+	tflog.Trace(ctx, fmt.Sprintf("added administrative unit member %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdministrativeUnitMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AdministrativeUnitMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	present, err := r.client.CheckAdministrativeUnitMember(data.AdministrativeUnitObjectID.Value, data.MemberObjectID.Value)
+	if msgraph.IsNotFound(err) || (err == nil && !present) {
+		tflog.Trace(ctx, fmt.Sprintf("administrative unit member %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read administrative unit members, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdministrativeUnitMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AdministrativeUnitMemberResourceModel
+
+	// All attributes require replacement, the plan is saved as is.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdministrativeUnitMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AdministrativeUnitMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.RemoveAdministrativeUnitMember(data.AdministrativeUnitObjectID.Value, data.MemberObjectID.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove administrative unit member, got error: %s", err))
+		return
+	}
+}
+
+func (r *AdministrativeUnitMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected administrativeUnitObjectId/memberObjectId: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("administrative_unit_object_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_object_id"), parts[1])...)
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AdministrativeUnitResource{}
+var _ resource.ResourceWithImportState = &AdministrativeUnitResource{}
+var _ resource.ResourceWithValidateConfig = &AdministrativeUnitResource{}
+
+func NewAdministrativeUnitResource() resource.Resource {
+	return &AdministrativeUnitResource{}
+}
+
+// AdministrativeUnitResource defines the resource implementation.
+type AdministrativeUnitResource struct {
+	client *msgraph.Client
+}
+
+// AdministrativeUnitResourceModel describes the resource data model.
+type AdministrativeUnitResourceModel struct {
+	Id                            types.String `tfsdk:"id"`
+	DisplayName                   types.String `tfsdk:"display_name"`
+	Description                   types.String `tfsdk:"description"`
+	Visibility                    types.String `tfsdk:"visibility"`
+	IsMemberManagementRestricted  types.Bool   `tfsdk:"is_member_management_restricted"`
+	MembershipType                types.String `tfsdk:"membership_type"`
+	MembershipRule                types.String `tfsdk:"membership_rule"`
+	MembershipRuleProcessingState types.String `tfsdk:"membership_rule_processing_state"`
+}
+
+func (r *AdministrativeUnitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_administrative_unit"
+}
+
+func (r *AdministrativeUnitResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Administrative unit resource, creates an administrative unit with assigned or dynamic membership",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Administrative Unit Object ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"description": {
+				MarkdownDescription: "Description",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"visibility": {
+				MarkdownDescription: "`Public` or `HiddenMembership`, hidden members are only visible to the administrators of the unit",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("Public", "HiddenMembership"),
+				},
+				Type: types.StringType,
+			},
+			"is_member_management_restricted": {
+				MarkdownDescription: "Whether the members can only be managed by the administrators scoped to the unit, can only be set when the unit is created",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Type: types.BoolType,
+			},
+			"membership_type": {
+				MarkdownDescription: "`Assigned` or `Dynamic`, defaults to `Assigned`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("Assigned", "Dynamic"),
+				},
+				Type: types.StringType,
+			},
+			"membership_rule": {
+				MarkdownDescription: "Dynamic membership rule, e.g. `user.department -eq \"Sales\"`. Requires the `Dynamic` membership type",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"membership_rule_processing_state": {
+				MarkdownDescription: "`On` or `Paused`, the graph api processes the rule when not set",
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("On", "Paused"),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *AdministrativeUnitResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AdministrativeUnitResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.MembershipType.IsUnknown() {
+		return
+	}
+
+	dynamic := data.MembershipType.Value == "Dynamic"
+	if dynamic && data.MembershipRule.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("membership_rule"), "Missing Attribute", "membership_rule must be set for the Dynamic membership type.")
+	}
+	if !dynamic && !data.MembershipRule.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("membership_rule"), "Invalid Attribute Combination", "membership_rule requires the Dynamic membership type.")
+	}
+	if !dynamic && !data.MembershipRuleProcessingState.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("membership_rule_processing_state"), "Invalid Attribute Combination", "membership_rule_processing_state requires the Dynamic membership type.")
+	}
+}
+
+func (r *AdministrativeUnitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AdministrativeUnitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AdministrativeUnitResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unit := expandAdministrativeUnit(data)
+	if !data.IsMemberManagementRestricted.IsUnknown() {
+		unit.IsMemberManagementRestricted = &data.IsMemberManagementRestricted.Value
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateAdministrativeUnit(unit)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create administrative unit, got error: %s", err))
+		return
+	}
+
+	flattenAdministrativeUnit(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created administrative unit %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdministrativeUnitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AdministrativeUnitResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	unit, err := r.client.GetAdministrativeUnit(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("administrative unit %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read administrative unit, got error: %s", err))
+		return
+	}
+
+	flattenAdministrativeUnit(data, unit)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdministrativeUnitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AdministrativeUnitResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unit := expandAdministrativeUnit(data)
+	unit.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateAdministrativeUnit(unit)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update administrative unit, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetAdministrativeUnit(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read administrative unit, got error: %s", err))
+		return
+	}
+
+	flattenAdministrativeUnit(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdministrativeUnitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AdministrativeUnitResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteAdministrativeUnit(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete administrative unit, got error: %s", err))
+		return
+	}
+}
+
+func (r *AdministrativeUnitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandAdministrativeUnit(data *AdministrativeUnitResourceModel) msgraph.AdministrativeUnit {
+	return msgraph.AdministrativeUnit{
+		DisplayName:                   data.DisplayName.Value,
+		Description:                   stringPointer(data.Description),
+		Visibility:                    stringPointer(data.Visibility),
+		MembershipType:                stringPointer(data.MembershipType),
+		MembershipRule:                stringPointer(data.MembershipRule),
+		MembershipRuleProcessingState: stringPointer(data.MembershipRuleProcessingState),
+	}
+}
+
+// flattenAdministrativeUnit updates the model from the graph api, the rule
+// processing state is only tracked when configured.
+func flattenAdministrativeUnit(data *AdministrativeUnitResourceModel, unit *msgraph.AdministrativeUnit) {
+	data.Id = types.String{Value: unit.ID}
+	data.DisplayName = types.String{Value: unit.DisplayName}
+	data.Description = optionalStringPointer(unit.Description)
+	data.Visibility = optionalStringPointer(unit.Visibility)
+	data.MembershipRule = optionalStringPointer(unit.MembershipRule)
+
+	data.MembershipType = types.String{Value: "Assigned"}
+	if unit.MembershipType != nil && *unit.MembershipType != "" {
+		data.MembershipType = types.String{Value: *unit.MembershipType}
+	}
+	if !data.MembershipRuleProcessingState.IsNull() {
+		data.MembershipRuleProcessingState = optionalStringPointer(unit.MembershipRuleProcessingState)
+	}
+
+	data.IsMemberManagementRestricted = types.Bool{Value: false}
+	if unit.IsMemberManagementRestricted != nil {
+		data.IsMemberManagementRestricted = types.Bool{Value: *unit.IsMemberManagementRestricted}
+	}
+}
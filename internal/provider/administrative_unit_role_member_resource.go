@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AdministrativeUnitRoleMemberResource{}
+var _ resource.ResourceWithImportState = &AdministrativeUnitRoleMemberResource{}
+
+func NewAdministrativeUnitRoleMemberResource() resource.Resource {
+	return &AdministrativeUnitRoleMemberResource{}
+}
+
+// AdministrativeUnitRoleMemberResource defines the resource implementation.
+type AdministrativeUnitRoleMemberResource struct {
+	client *msgraph.Client
+}
+
+// AdministrativeUnitRoleMemberResourceModel describes the resource data model.
+type AdministrativeUnitRoleMemberResourceModel struct {
+	Id                         types.String `tfsdk:"id"`
+	ScopedRoleMembershipID     types.String `tfsdk:"scoped_role_membership_id"`
+	AdministrativeUnitObjectID types.String `tfsdk:"administrative_unit_object_id"`
+	RoleObjectID               types.String `tfsdk:"role_object_id"`
+	MemberObjectID             types.String `tfsdk:"member_object_id"`
+}
+
+func (r *AdministrativeUnitRoleMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_administrative_unit_role_member"
+}
+
+func (r *AdministrativeUnitRoleMemberResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Administrative unit role member resource, assigns a directory role to a principal scoped to an administrative unit",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier in the form `administrativeUnitObjectId/scopedRoleMembershipId`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"scoped_role_membership_id": {
+				Computed:            true,
+				MarkdownDescription: "Scoped role membership ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"administrative_unit_object_id": {
+				MarkdownDescription: "Administrative Unit Object ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"role_object_id": {
+				MarkdownDescription: "Object ID of the activated directory role, e.g. User Administrator. This is not the role template ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"member_object_id": {
+				MarkdownDescription: "Object ID of the user, role assignable group or service principal the role is assigned to",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *AdministrativeUnitRoleMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AdministrativeUnitRoleMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AdministrativeUnitRoleMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateScopedRoleMembership(data.AdministrativeUnitObjectID.Value, msgraph.ScopedRoleMembership{
+		RoleID:         data.RoleObjectID.Value,
+		RoleMemberInfo: msgraph.Identity{ID: data.MemberObjectID.Value},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create scoped role membership, got error: %s", err))
+		return
+	}
+
+	flattenScopedRoleMembership(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created scoped role membership %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdministrativeUnitRoleMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AdministrativeUnitRoleMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	membership, err := r.client.GetScopedRoleMembership(data.AdministrativeUnitObjectID.Value, data.ScopedRoleMembershipID.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("scoped role membership %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scoped role membership, got error: %s", err))
+		return
+	}
+
+	flattenScopedRoleMembership(data, membership)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdministrativeUnitRoleMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AdministrativeUnitRoleMemberResourceModel
+
+	// All attributes require replacement, the plan is saved as is.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdministrativeUnitRoleMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AdministrativeUnitRoleMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteScopedRoleMembership(data.AdministrativeUnitObjectID.Value, data.ScopedRoleMembershipID.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete scoped role membership, got error: %s", err))
+		return
+	}
+}
+
+func (r *AdministrativeUnitRoleMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected administrativeUnitObjectId/scopedRoleMembershipId: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("administrative_unit_object_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scoped_role_membership_id"), parts[1])...)
+}
+
+func flattenScopedRoleMembership(data *AdministrativeUnitRoleMemberResourceModel, membership *msgraph.ScopedRoleMembership) {
+	data.Id = types.String{Value: fmt.Sprintf("%s/%s", data.AdministrativeUnitObjectID.Value, membership.ID)}
+	data.ScopedRoleMembershipID = types.String{Value: membership.ID}
+	data.RoleObjectID = types.String{Value: membership.RoleID}
+	data.MemberObjectID = types.String{Value: membership.RoleMemberInfo.ID}
+}
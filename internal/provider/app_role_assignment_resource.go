@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AppRoleAssignmentResource{}
+var _ resource.ResourceWithImportState = &AppRoleAssignmentResource{}
+var _ resource.ResourceWithValidateConfig = &AppRoleAssignmentResource{}
+
+func NewAppRoleAssignmentResource() resource.Resource {
+	return &AppRoleAssignmentResource{}
+}
+
+// AppRoleAssignmentResource defines the resource implementation.
+type AppRoleAssignmentResource struct {
+	client *msgraph.Client
+}
+
+// AppRoleAssignmentResourceModel describes the resource data model.
+type AppRoleAssignmentResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	AssignmentID         types.String `tfsdk:"assignment_id"`
+	ResourceObjectID     types.String `tfsdk:"resource_object_id"`
+	PrincipalObjectID    types.String `tfsdk:"principal_object_id"`
+	AppRoleID            types.String `tfsdk:"app_role_id"`
+	AppRoleValue         types.String `tfsdk:"app_role_value"`
+	PrincipalDisplayName types.String `tfsdk:"principal_display_name"`
+	PrincipalType        types.String `tfsdk:"principal_type"`
+	ResourceDisplayName  types.String `tfsdk:"resource_display_name"`
+}
+
+func (r *AppRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_role_assignment"
+}
+
+func (r *AppRoleAssignmentResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "App role assignment resource, grants an application permission to a service principal or assigns a user or group to an app role of an enterprise application",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier in the form `resourceSpId/assignmentId`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"assignment_id": {
+				Computed:            true,
+				MarkdownDescription: "App role assignment ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"resource_object_id": {
+				MarkdownDescription: "Object ID of the resource service principal publishing the app role, e.g. Microsoft Graph",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"principal_object_id": {
+				MarkdownDescription: "Object ID of the user, group or service principal the role is assigned to",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"app_role_id": {
+				MarkdownDescription: fmt.Sprintf("App role ID, `%s` assigns the default access role. Conflicts with `app_role_value`", msgraph.DefaultAppRoleID),
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"app_role_value": {
+				MarkdownDescription: "App role value, e.g. `Application.Read.All`, resolved to the app role ID of the resource. Conflicts with `app_role_id`",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					// Imported assignments have no value in state yet, setting
+					// it afterwards must not replace the assignment.
+					resource.RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, _ path.Path) (bool, diag.Diagnostics) {
+						return !state.IsNull(), nil
+					}, "Replaces the assignment when the app role value changes.", "Replaces the assignment when the app role value changes."),
+				},
+				Type: types.StringType,
+			},
+			"principal_display_name": {
+				Computed:            true,
+				MarkdownDescription: "Display name of the principal",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"principal_type": {
+				Computed:            true,
+				MarkdownDescription: "Type of the principal, `User`, `Group` or `ServicePrincipal`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"resource_display_name": {
+				Computed:            true,
+				MarkdownDescription: "Display name of the resource service principal",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *AppRoleAssignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AppRoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AppRoleID.IsNull() && !data.AppRoleValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("app_role_value"), "Conflicting Attributes", "Only one of app_role_id or app_role_value can be set.")
+	}
+	if data.AppRoleID.IsNull() && data.AppRoleValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("app_role_id"), "Missing Attribute", "One of app_role_id or app_role_value must be set.")
+	}
+}
+
+func (r *AppRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AppRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AppRoleAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+
+	appRoleID := data.AppRoleID.Value
+	if !data.AppRoleValue.IsNull() {
+		var err error
+		appRoleID, err = r.client.GetAppRoleIDByValue(data.ResourceObjectID.Value, data.AppRoleValue.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve app role, got error: %s", err))
+			return
+		}
+	}
+
+	created, err := r.client.CreateAppRoleAssignment(msgraph.AppRoleAssignment{
+		PrincipalID: data.PrincipalObjectID.Value,
+		ResourceID:  data.ResourceObjectID.Value,
+		AppRoleID:   appRoleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app role assignment, got error: %s", err))
+		return
+	}
+
+	flattenAppRoleAssignment(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created app role assignment %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AppRoleAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	assignment, err := r.client.GetAppRoleAssignment(data.ResourceObjectID.Value, data.AssignmentID.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("app role assignment %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read app role assignment, got error: %s", err))
+		return
+	}
+
+	flattenAppRoleAssignment(data, assignment)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AppRoleAssignmentResourceModel
+
+	// All attributes require replacement, the plan is saved as is.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AppRoleAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteAppRoleAssignment(data.ResourceObjectID.Value, data.AssignmentID.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete app role assignment, got error: %s", err))
+		return
+	}
+}
+
+func (r *AppRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected resourceSpId/assignmentId: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_object_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("assignment_id"), parts[1])...)
+}
+
+func flattenAppRoleAssignment(data *AppRoleAssignmentResourceModel, assignment *msgraph.AppRoleAssignment) {
+	data.Id = types.String{Value: fmt.Sprintf("%s/%s", assignment.ResourceID, assignment.ID)}
+	data.AssignmentID = types.String{Value: assignment.ID}
+	data.ResourceObjectID = types.String{Value: assignment.ResourceID}
+	data.PrincipalObjectID = types.String{Value: assignment.PrincipalID}
+	data.AppRoleID = types.String{Value: assignment.AppRoleID}
+	data.PrincipalDisplayName = types.String{Value: assignment.PrincipalDisplayName}
+	data.PrincipalType = types.String{Value: assignment.PrincipalType}
+	data.ResourceDisplayName = types.String{Value: assignment.ResourceDisplayName}
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ApplicationBrandingResource{}
+var _ resource.ResourceWithImportState = &ApplicationBrandingResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationBrandingResource{}
+
+func NewApplicationBrandingResource() resource.Resource {
+	return &ApplicationBrandingResource{}
+}
+
+// ApplicationBrandingResource defines the resource implementation.
+type ApplicationBrandingResource struct {
+	client *msgraph.Client
+}
+
+// ApplicationBrandingResourceModel describes the resource data model.
+type ApplicationBrandingResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	ApplicationObjectID types.String `tfsdk:"application_object_id"`
+	MarketingURL        types.String `tfsdk:"marketing_url"`
+	PrivacyStatementURL types.String `tfsdk:"privacy_statement_url"`
+	SupportURL          types.String `tfsdk:"support_url"`
+	TermsOfServiceURL   types.String `tfsdk:"terms_of_service_url"`
+	LogoPath            types.String `tfsdk:"logo_path"`
+	LogoHash            types.String `tfsdk:"logo_hash"`
+	LogoURL             types.String `tfsdk:"logo_url"`
+}
+
+func (r *ApplicationBrandingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_branding"
+}
+
+func (r *ApplicationBrandingResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Application branding resource, manages the informational URLs and the logo of an application. The logo is kept when the resource is destroyed",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"application_object_id": {
+				MarkdownDescription: "Application Object ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"marketing_url": {
+				MarkdownDescription: "Link to the marketing page of the application",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"privacy_statement_url": {
+				MarkdownDescription: "Link to the privacy statement of the application",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"support_url": {
+				MarkdownDescription: "Link to the support page of the application",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"terms_of_service_url": {
+				MarkdownDescription: "Link to the terms of service of the application",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"logo_path": {
+				MarkdownDescription: "Path to a local PNG or JPG logo of at most 100 KB",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"logo_hash": {
+				Computed:            true,
+				MarkdownDescription: "SHA256 hash of the uploaded logo, used to detect changes of the file",
+				Type:                types.StringType,
+			},
+			"logo_url": {
+				Computed:            true,
+				MarkdownDescription: "CDN URL of the uploaded logo",
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *ApplicationBrandingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes the logo file so a changed image shows up in the plan,
+// the graph api does not return the logo bytes to compare against.
+func (r *ApplicationBrandingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to hash when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var logoPath types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("logo_path"), &logoPath)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if logoPath.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("logo_hash"), types.String{Unknown: true})...)
+		return
+	}
+
+	if logoPath.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("logo_hash"), types.String{Null: true})...)
+		return
+	}
+
+	_, _, hash, err := readApplicationLogo(logoPath.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("logo_path"), "Invalid Logo", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("logo_hash"), hash)...)
+}
+
+func (r *ApplicationBrandingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ApplicationBrandingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, !data.LogoPath.IsNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationBrandingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ApplicationBrandingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	application, err := r.client.GetApplicationInfo(data.ApplicationObjectID.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("application %s not found, removing from state", data.ApplicationObjectID.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application info, got error: %s", err))
+		return
+	}
+
+	flattenApplicationBranding(data, application)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationBrandingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ApplicationBrandingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *ApplicationBrandingResourceModel
+	// Read Terraform State data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uploadLogo := !data.LogoPath.IsNull() && data.LogoHash.Value != state.LogoHash.Value
+	resp.Diagnostics.Append(r.apply(ctx, data, uploadLogo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationBrandingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ApplicationBrandingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.PatchApplicationInfo(data.ApplicationObjectID.Value, msgraph.InformationalURL{})
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear application info, got error: %s", err))
+		return
+	}
+}
+
+func (r *ApplicationBrandingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_object_id"), req.ID)...)
+}
+
+func (r *ApplicationBrandingResource) apply(ctx context.Context, data *ApplicationBrandingResourceModel, uploadLogo bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The logo is read and checked against the planned hash before anything
+	// is changed, the file may have changed since the plan.
+	var logo []byte
+	var contentType string
+	if uploadLogo {
+		var hash string
+		var err error
+		logo, contentType, hash, err = readApplicationLogo(data.LogoPath.Value)
+		if err != nil {
+			diags.AddAttributeError(path.Root("logo_path"), "Invalid Logo", err.Error())
+			return diags
+		}
+		if !data.LogoHash.IsUnknown() && !data.LogoHash.IsNull() && data.LogoHash.Value != hash {
+			diags.AddAttributeError(path.Root("logo_path"), "Logo Changed", fmt.Sprintf("Logo %s changed after the plan was made, plan again to upload the new logo.", data.LogoPath.Value))
+			return diags
+		}
+		data.LogoHash = types.String{Value: hash}
+	}
+
+	info := msgraph.InformationalURL{
+		MarketingURL:        stringPointer(data.MarketingURL),
+		PrivacyStatementURL: stringPointer(data.PrivacyStatementURL),
+		SupportURL:          stringPointer(data.SupportURL),
+		TermsOfServiceURL:   stringPointer(data.TermsOfServiceURL),
+	}
+
+	r.client.GraphAccess()
+	err := r.client.PatchApplicationInfo(data.ApplicationObjectID.Value, info)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to patch application info, got error: %s", err))
+		return diags
+	}
+
+	if uploadLogo {
+		err = r.client.PutApplicationLogo(data.ApplicationObjectID.Value, contentType, logo)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to upload application logo, got error: %s", err))
+			return diags
+		}
+		tflog.Trace(ctx, fmt.Sprintf("uploaded logo %s to application %s", data.LogoPath.Value, data.ApplicationObjectID.Value))
+	}
+
+	application, err := r.client.GetApplicationInfo(data.ApplicationObjectID.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read application info, got error: %s", err))
+		return diags
+	}
+
+	flattenApplicationBranding(data, application)
+
+	return diags
+}
+
+// readApplicationLogo reads a logo file and returns its content, content
+// type and hex encoded sha256 hash.
+func readApplicationLogo(logoPath string) ([]byte, string, string, error) {
+	logo, err := os.ReadFile(logoPath)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("unable to read logo: %s", err)
+	}
+
+	if len(logo) > msgraph.MaxApplicationLogoSize {
+		return nil, "", "", fmt.Errorf("logo %s is %d bytes, the maximum is %d", logoPath, len(logo), msgraph.MaxApplicationLogoSize)
+	}
+
+	contentType := http.DetectContentType(logo)
+	if contentType != "image/png" && contentType != "image/jpeg" {
+		return nil, "", "", fmt.Errorf("logo %s must be a PNG or JPG image, got %s", logoPath, contentType)
+	}
+
+	sum := sha256.Sum256(logo)
+	return logo, contentType, hex.EncodeToString(sum[:]), nil
+}
+
+func flattenApplicationBranding(data *ApplicationBrandingResourceModel, application *msgraph.Application) {
+	data.Id = types.String{Value: application.ID}
+	data.MarketingURL = optionalStringPointer(application.Info.MarketingURL)
+	data.PrivacyStatementURL = optionalStringPointer(application.Info.PrivacyStatementURL)
+	data.SupportURL = optionalStringPointer(application.Info.SupportURL)
+	data.TermsOfServiceURL = optionalStringPointer(application.Info.TermsOfServiceURL)
+	data.LogoURL = optionalString(application.Info.LogoURL)
+
+	if data.LogoHash.IsUnknown() {
+		data.LogoHash = types.String{Null: true}
+	}
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ApplicationExtensionPropertyResource{}
+var _ resource.ResourceWithImportState = &ApplicationExtensionPropertyResource{}
+
+var extensionTargetObjectPattern = regexp.MustCompile(`^(User|Group|AdministrativeUnit|Application|Device|Organization)$`)
+
+func NewApplicationExtensionPropertyResource() resource.Resource {
+	return &ApplicationExtensionPropertyResource{}
+}
+
+// ApplicationExtensionPropertyResource defines the resource implementation.
+type ApplicationExtensionPropertyResource struct {
+	client *msgraph.Client
+}
+
+// ApplicationExtensionPropertyResourceModel describes the resource data model.
+type ApplicationExtensionPropertyResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	ExtensionPropertyID types.String `tfsdk:"extension_property_id"`
+	ApplicationObjectID types.String `tfsdk:"application_object_id"`
+	Name                types.String `tfsdk:"name"`
+	DataType            types.String `tfsdk:"data_type"`
+	TargetObjects       types.Set    `tfsdk:"target_objects"`
+	IsMultiValued       types.Bool   `tfsdk:"is_multi_valued"`
+	FullName            types.String `tfsdk:"full_name"`
+}
+
+func (r *ApplicationExtensionPropertyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_extension_property"
+}
+
+func (r *ApplicationExtensionPropertyResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Application extension property resource, defines a directory extension property named `extension_{appId}_{name}` on an application. Extension properties cannot be changed, every change replaces the property",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier in the form `applicationObjectId/extensionPropertyId`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"extension_property_id": {
+				Computed:            true,
+				MarkdownDescription: "Extension property ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"application_object_id": {
+				MarkdownDescription: "Object ID of the application the property is defined on",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"name": {
+				MarkdownDescription: "Name of the property without the extension prefix, e.g. `costCenter`",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"data_type": {
+				MarkdownDescription: "`Binary`, `Boolean`, `DateTime`, `Integer`, `LargeInteger` or `String`",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("Binary", "Boolean", "DateTime", "Integer", "LargeInteger", "String"),
+				},
+				Type: types.StringType,
+			},
+			"target_objects": {
+				MarkdownDescription: "Object types the property can be set on, `User`, `Group`, `AdministrativeUnit`, `Application`, `Device` or `Organization`",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringSetMatches(extensionTargetObjectPattern, "values must be one of: User, Group, AdministrativeUnit, Application, Device, Organization"),
+				},
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"is_multi_valued": {
+				MarkdownDescription: "Whether the property holds a collection of values",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.BoolType,
+			},
+			"full_name": {
+				Computed:            true,
+				MarkdownDescription: "Name of the property on the target objects, e.g. `extension_00000000000000000000000000000000_costCenter`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *ApplicationExtensionPropertyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationExtensionPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ApplicationExtensionPropertyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targetObjects, diags := setToStrings(ctx, data.TargetObjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateExtensionProperty(data.ApplicationObjectID.Value, msgraph.ExtensionProperty{
+		Name:          data.Name.Value,
+		DataType:      data.DataType.Value,
+		TargetObjects: targetObjects,
+		IsMultiValued: data.IsMultiValued.Value,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create extension property, got error: %s", err))
+		return
+	}
+
+	flattenExtensionProperty(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created extension property %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationExtensionPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ApplicationExtensionPropertyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	property, err := r.client.GetExtensionProperty(data.ApplicationObjectID.Value, data.ExtensionPropertyID.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("extension property %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read extension property, got error: %s", err))
+		return
+	}
+
+	flattenExtensionProperty(data, property)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationExtensionPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ApplicationExtensionPropertyResourceModel
+
+	// All attributes require replacement, the plan is saved as is.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationExtensionPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ApplicationExtensionPropertyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteExtensionProperty(data.ApplicationObjectID.Value, data.ExtensionPropertyID.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete extension property, got error: %s", err))
+		return
+	}
+}
+
+func (r *ApplicationExtensionPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected applicationObjectId/extensionPropertyId: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_object_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("extension_property_id"), parts[1])...)
+}
+
+// flattenExtensionProperty updates the model from the graph api, which
+// returns the name with the extension_{appId}_ prefix.
+func flattenExtensionProperty(data *ApplicationExtensionPropertyResourceModel, property *msgraph.ExtensionProperty) {
+	data.Id = types.String{Value: fmt.Sprintf("%s/%s", data.ApplicationObjectID.Value, property.ID)}
+	data.ExtensionPropertyID = types.String{Value: property.ID}
+	data.FullName = types.String{Value: property.Name}
+	data.DataType = types.String{Value: property.DataType}
+	data.TargetObjects = stringsToSet(property.TargetObjects)
+	data.IsMultiValued = optionalBool(&property.IsMultiValued, data.IsMultiValued)
+
+	if parts := strings.SplitN(property.Name, "_", 3); len(parts) == 3 && parts[0] == "extension" {
+		data.Name = types.String{Value: parts[2]}
+	}
+}
@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ApplicationFederatedIdentityCredentialResource{}
+var _ resource.ResourceWithImportState = &ApplicationFederatedIdentityCredentialResource{}
+
+func NewApplicationFederatedIdentityCredentialResource() resource.Resource {
+	return &ApplicationFederatedIdentityCredentialResource{}
+}
+
+// ApplicationFederatedIdentityCredentialResource defines the resource implementation.
+type ApplicationFederatedIdentityCredentialResource struct {
+	client *msgraph.Client
+}
+
+// ApplicationFederatedIdentityCredentialResourceModel describes the resource data model.
+type ApplicationFederatedIdentityCredentialResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	ApplicationObjectID types.String `tfsdk:"application_object_id"`
+	CredentialID        types.String `tfsdk:"credential_id"`
+	Name                types.String `tfsdk:"name"`
+	Issuer              types.String `tfsdk:"issuer"`
+	Subject             types.String `tfsdk:"subject"`
+	Audiences           types.List   `tfsdk:"audiences"`
+	Description         types.String `tfsdk:"description"`
+}
+
+func (r *ApplicationFederatedIdentityCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_federated_identity_credential"
+}
+
+func (r *ApplicationFederatedIdentityCredentialResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Application federated identity credential resource, used to trust tokens issued by an external identity provider such as AKS or GitHub Actions",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier in the form `objectId/credentialId`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"application_object_id": {
+				MarkdownDescription: "Application Object ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"credential_id": {
+				Computed:            true,
+				MarkdownDescription: "Federated identity credential ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"name": {
+				MarkdownDescription: "Unique name of the credential, cannot be changed after creation",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"issuer": {
+				MarkdownDescription: "URL of the external identity provider",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"subject": {
+				MarkdownDescription: "Identifier of the external workload within the issuer",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"audiences": {
+				MarkdownDescription: "Audiences that can appear in the external token, usually `api://AzureADTokenExchange`",
+				Required:            true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"description": {
+				MarkdownDescription: "Description of the credential",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *ApplicationFederatedIdentityCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationFederatedIdentityCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ApplicationFederatedIdentityCredentialResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, diags := expandFederatedIdentityCredential(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateFederatedIdentityCredential(data.ApplicationObjectID.Value, credential)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create federated identity credential, got error: %s", err))
+		return
+	}
+
+	flattenFederatedIdentityCredential(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created federated identity credential %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationFederatedIdentityCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ApplicationFederatedIdentityCredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	credential, err := r.client.GetFederatedIdentityCredential(data.ApplicationObjectID.Value, data.CredentialID.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("federated identity credential %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read federated identity credential, got error: %s", err))
+		return
+	}
+
+	flattenFederatedIdentityCredential(data, credential)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationFederatedIdentityCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ApplicationFederatedIdentityCredentialResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, diags := expandFederatedIdentityCredential(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	credential.ID = data.CredentialID.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateFederatedIdentityCredential(data.ApplicationObjectID.Value, credential)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update federated identity credential, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetFederatedIdentityCredential(data.ApplicationObjectID.Value, data.CredentialID.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read federated identity credential, got error: %s", err))
+		return
+	}
+
+	flattenFederatedIdentityCredential(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationFederatedIdentityCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ApplicationFederatedIdentityCredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteFederatedIdentityCredential(data.ApplicationObjectID.Value, data.CredentialID.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete federated identity credential, got error: %s", err))
+		return
+	}
+}
+
+func (r *ApplicationFederatedIdentityCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected objectId/credentialId: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_object_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credential_id"), parts[1])...)
+}
+
+func expandFederatedIdentityCredential(ctx context.Context, data *ApplicationFederatedIdentityCredentialResourceModel) (msgraph.FederatedIdentityCredential, diag.Diagnostics) {
+	audiences, diags := listToStrings(ctx, data.Audiences)
+
+	return msgraph.FederatedIdentityCredential{
+		Name:        data.Name.Value,
+		Issuer:      data.Issuer.Value,
+		Subject:     data.Subject.Value,
+		Description: data.Description.Value,
+		Audiences:   audiences,
+	}, diags
+}
+
+func flattenFederatedIdentityCredential(data *ApplicationFederatedIdentityCredentialResourceModel, credential *msgraph.FederatedIdentityCredential) {
+	data.Id = types.String{Value: fmt.Sprintf("%s/%s", data.ApplicationObjectID.Value, credential.ID)}
+	data.CredentialID = types.String{Value: credential.ID}
+	data.Name = types.String{Value: credential.Name}
+	data.Issuer = types.String{Value: credential.Issuer}
+	data.Subject = types.String{Value: credential.Subject}
+	data.Audiences = stringsToList(credential.Audiences)
+	data.Description = optionalString(credential.Description)
+}
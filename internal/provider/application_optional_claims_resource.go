@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ApplicationOptionalClaimsResource{}
+var _ resource.ResourceWithImportState = &ApplicationOptionalClaimsResource{}
+
+func NewApplicationOptionalClaimsResource() resource.Resource {
+	return &ApplicationOptionalClaimsResource{}
+}
+
+// ApplicationOptionalClaimsResource defines the resource implementation.
+type ApplicationOptionalClaimsResource struct {
+	client *msgraph.Client
+}
+
+// ApplicationOptionalClaimsResourceModel describes the resource data model.
+type ApplicationOptionalClaimsResourceModel struct {
+	Id                    types.String                    `tfsdk:"id"`
+	ApplicationObjectID   types.String                    `tfsdk:"application_object_id"`
+	GroupMembershipClaims types.String                    `tfsdk:"group_membership_claims"`
+	IDToken               []ApplicationOptionalClaimModel `tfsdk:"id_token"`
+	AccessToken           []ApplicationOptionalClaimModel `tfsdk:"access_token"`
+	Saml2Token            []ApplicationOptionalClaimModel `tfsdk:"saml2_token"`
+}
+
+// ApplicationOptionalClaimModel describes a single optional claim.
+type ApplicationOptionalClaimModel struct {
+	Name                 types.String `tfsdk:"name"`
+	Source               types.String `tfsdk:"source"`
+	Essential            types.Bool   `tfsdk:"essential"`
+	AdditionalProperties types.List   `tfsdk:"additional_properties"`
+}
+
+func (r *ApplicationOptionalClaimsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_optional_claims"
+}
+
+func optionalClaimAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"name": {
+			MarkdownDescription: "Name of the claim, e.g. `groups`, `email`, `upn` or `extension_<appId>_<attribute>`",
+			Required:            true,
+			Type:                types.StringType,
+		},
+		"source": {
+			MarkdownDescription: "Source of the claim, `user` for directory extension attributes",
+			Optional:            true,
+			Computed:            true,
+			Type:                types.StringType,
+		},
+		"essential": {
+			MarkdownDescription: "Whether the claim is required for a smooth authorization experience",
+			Optional:            true,
+			Computed:            true,
+			Type:                types.BoolType,
+		},
+		"additional_properties": {
+			MarkdownDescription: "Additional properties of the claim, e.g. `sam_account_name` or `emit_as_roles`. Must not be empty, omit it instead",
+			Optional:            true,
+			Validators: []tfsdk.AttributeValidator{
+				listNotEmpty(),
+			},
+			Type: types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ApplicationOptionalClaimsResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Application optional claims resource, manages the optional claims and group claims issued in tokens",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"application_object_id": {
+				MarkdownDescription: "Application Object ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"group_membership_claims": {
+				MarkdownDescription: "Groups claim issued in tokens, one of `None`, `SecurityGroup`, `DirectoryRole`, `ApplicationGroup` or `All`",
+				Optional:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("None", "SecurityGroup", "DirectoryRole", "ApplicationGroup", "All"),
+				},
+			},
+			"id_token": {
+				MarkdownDescription: "Optional claims in the ID token",
+				Optional:            true,
+				Attributes:          tfsdk.ListNestedAttributes(optionalClaimAttributes()),
+			},
+			"access_token": {
+				MarkdownDescription: "Optional claims in the access token",
+				Optional:            true,
+				Attributes:          tfsdk.ListNestedAttributes(optionalClaimAttributes()),
+			},
+			"saml2_token": {
+				MarkdownDescription: "Optional claims in the SAML token",
+				Optional:            true,
+				Attributes:          tfsdk.ListNestedAttributes(optionalClaimAttributes()),
+			},
+		},
+	}, nil
+}
+
+func (r *ApplicationOptionalClaimsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationOptionalClaimsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ApplicationOptionalClaimsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationOptionalClaimsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ApplicationOptionalClaimsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	application, err := r.client.GetApplicationOptionalClaims(data.ApplicationObjectID.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("application %s not found, removing from state", data.ApplicationObjectID.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application optional claims, got error: %s", err))
+		return
+	}
+
+	flattenApplicationOptionalClaims(data, application)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationOptionalClaimsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ApplicationOptionalClaimsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationOptionalClaimsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ApplicationOptionalClaimsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.PatchApplicationOptionalClaims(data.ApplicationObjectID.Value, msgraph.OptionalClaims{}, nil)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove application optional claims, got error: %s", err))
+		return
+	}
+}
+
+func (r *ApplicationOptionalClaimsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_object_id"), req.ID)...)
+}
+
+func (r *ApplicationOptionalClaimsResource) apply(ctx context.Context, data *ApplicationOptionalClaimsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	optionalClaims := msgraph.OptionalClaims{}
+	for _, claims := range []struct {
+		from []ApplicationOptionalClaimModel
+		to   *[]msgraph.OptionalClaim
+	}{
+		{data.IDToken, &optionalClaims.IDToken},
+		{data.AccessToken, &optionalClaims.AccessToken},
+		{data.Saml2Token, &optionalClaims.Saml2Token},
+	} {
+		expanded, d := expandOptionalClaims(ctx, claims.from)
+		diags.Append(d...)
+		*claims.to = expanded
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	var groupMembershipClaims *string
+	if !data.GroupMembershipClaims.IsNull() {
+		groupMembershipClaims = &data.GroupMembershipClaims.Value
+	}
+
+	r.client.GraphAccess()
+	err := r.client.PatchApplicationOptionalClaims(data.ApplicationObjectID.Value, optionalClaims, groupMembershipClaims)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to patch application optional claims, got error: %s", err))
+		return diags
+	}
+
+	application, err := r.client.GetApplicationOptionalClaims(data.ApplicationObjectID.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read application optional claims, got error: %s", err))
+		return diags
+	}
+
+	flattenApplicationOptionalClaims(data, application)
+	tflog.Trace(ctx, fmt.Sprintf("patched optional claims of application %s", data.Id.Value))
+
+	return diags
+}
+
+func expandOptionalClaims(ctx context.Context, claims []ApplicationOptionalClaimModel) ([]msgraph.OptionalClaim, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := make([]msgraph.OptionalClaim, 0)
+	for i := 0; i < len(claims); i++ {
+		additionalProperties, d := listToStrings(ctx, claims[i].AdditionalProperties)
+		diags.Append(d...)
+
+		claim := msgraph.OptionalClaim{
+			Name:                 claims[i].Name.Value,
+			Essential:            claims[i].Essential.Value,
+			AdditionalProperties: additionalProperties,
+		}
+		if !claims[i].Source.IsNull() && !claims[i].Source.IsUnknown() {
+			claim.Source = &claims[i].Source.Value
+		}
+		result = append(result, claim)
+	}
+
+	return result, diags
+}
+
+func flattenOptionalClaims(claims []msgraph.OptionalClaim) []ApplicationOptionalClaimModel {
+	var result []ApplicationOptionalClaimModel
+	for i := 0; i < len(claims); i++ {
+		claim := ApplicationOptionalClaimModel{
+			Name:                 types.String{Value: claims[i].Name},
+			Source:               types.String{Null: true},
+			Essential:            types.Bool{Value: claims[i].Essential},
+			AdditionalProperties: types.List{Null: true, ElemType: types.StringType},
+		}
+		if claims[i].Source != nil {
+			claim.Source = types.String{Value: *claims[i].Source}
+		}
+		if len(claims[i].AdditionalProperties) > 0 {
+			claim.AdditionalProperties = stringsToList(claims[i].AdditionalProperties)
+		}
+		result = append(result, claim)
+	}
+
+	return result
+}
+
+func flattenApplicationOptionalClaims(data *ApplicationOptionalClaimsResourceModel, application *msgraph.Application) {
+	data.Id = types.String{Value: application.ID}
+	// The graph api returns null rather than None when the groups claim is off.
+	if application.GroupMembershipClaims != "" || data.GroupMembershipClaims.Value != "None" {
+		data.GroupMembershipClaims = optionalString(application.GroupMembershipClaims)
+	}
+	data.IDToken = flattenOptionalClaims(application.OptionalClaims.IDToken)
+	data.AccessToken = flattenOptionalClaims(application.OptionalClaims.AccessToken)
+	data.Saml2Token = flattenOptionalClaims(application.OptionalClaims.Saml2Token)
+}
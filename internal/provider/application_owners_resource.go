@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ApplicationOwnersResource{}
+var _ resource.ResourceWithImportState = &ApplicationOwnersResource{}
+var _ resource.ResourceWithValidateConfig = &ApplicationOwnersResource{}
+
+func NewApplicationOwnersResource() resource.Resource {
+	return &ApplicationOwnersResource{}
+}
+
+// minimumApplicationOwners is the number of human owners every application
+// managed by the authoritative resource must keep.
+const minimumApplicationOwners = 2
+
+// ApplicationOwnersResource defines the resource implementation.
+type ApplicationOwnersResource struct {
+	client *msgraph.Client
+}
+
+// ApplicationOwnersResourceModel describes the resource data model.
+type ApplicationOwnersResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	ApplicationObjectID types.String `tfsdk:"application_object_id"`
+	OwnerObjectIDs      types.Set    `tfsdk:"owner_object_ids"`
+}
+
+func (r *ApplicationOwnersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_owners"
+}
+
+func (r *ApplicationOwnersResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Authoritative application owners resource, owners not listed are removed. Every application keeps at least two owners, which must be users",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"application_object_id": {
+				MarkdownDescription: "Application Object ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"owner_object_ids": {
+				MarkdownDescription: "Object IDs of all owners of the application, at least two users are required",
+				Required:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+		},
+	}, nil
+}
+
+func (r *ApplicationOwnersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ApplicationOwnersResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.OwnerObjectIDs.IsUnknown() && len(data.OwnerObjectIDs.Elems) < minimumApplicationOwners {
+		resp.Diagnostics.AddAttributeError(path.Root("owner_object_ids"), "Invalid Owners", fmt.Sprintf("At least %d owners are required.", minimumApplicationOwners))
+	}
+}
+
+func (r *ApplicationOwnersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationOwnersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ApplicationOwnersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncOwners(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationOwnersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ApplicationOwnersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	owners, err := r.client.ListApplicationOwners(data.ApplicationObjectID.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("application %s not found, removing from state", data.ApplicationObjectID.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application owners, got error: %s", err))
+		return
+	}
+
+	ownerIDs := make([]string, 0)
+	for i := 0; i < len(owners); i++ {
+		ownerIDs = append(ownerIDs, owners[i].ID)
+	}
+	data.OwnerObjectIDs = stringsToSet(ownerIDs)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationOwnersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ApplicationOwnersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncOwners(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationOwnersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ApplicationOwnersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing every owner would leave the application orphaned, so the
+	// owners are left in place and only dropped from the state.
+	resp.Diagnostics.AddWarning(
+		"Application owners left in place",
+		fmt.Sprintf("The owners of application %s were not removed, an application must keep at least one owner.", data.ApplicationObjectID.Value),
+	)
+}
+
+func (r *ApplicationOwnersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_object_id"), req.ID)...)
+}
+
+// syncOwners adds the missing owners before removing the extra ones, so the
+// application is never left without an owner while the change is applied.
+func (r *ApplicationOwnersResource) syncOwners(ctx context.Context, data *ApplicationOwnersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	desired, d := setToStrings(ctx, data.OwnerObjectIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(desired) < minimumApplicationOwners {
+		diags.AddAttributeError(path.Root("owner_object_ids"), "Invalid Owners", fmt.Sprintf("Refusing to change the owners of the application, at least %d owners are required.", minimumApplicationOwners))
+		return diags
+	}
+
+	// Owners must be humans, service principals do not count towards the
+	// minimum and are rejected before anything is changed.
+	r.client.GraphAccess()
+	for i := 0; i < len(desired); i++ {
+		object, err := r.client.GetDirectoryObject(desired[i])
+		if msgraph.IsNotFound(err) {
+			diags.AddAttributeError(path.Root("owner_object_ids"), "Invalid Owners", fmt.Sprintf("Owner %s does not exist.", desired[i]))
+			return diags
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read owner %s, got error: %s", desired[i], err))
+			return diags
+		}
+		if object.Type() != "user" {
+			diags.AddAttributeError(path.Root("owner_object_ids"), "Invalid Owners", fmt.Sprintf("Owner %s is a %s, application owners must be users.", desired[i], object.Type()))
+			return diags
+		}
+	}
+
+	owners, err := r.client.ListApplicationOwners(data.ApplicationObjectID.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read application owners, got error: %s", err))
+		return diags
+	}
+
+	current := make([]string, 0)
+	for i := 0; i < len(owners); i++ {
+		current = append(current, owners[i].ID)
+	}
+
+	for i := 0; i < len(desired); i++ {
+		if containsString(current, desired[i]) {
+			continue
+		}
+
+		err = r.client.AddApplicationOwner(data.ApplicationObjectID.Value, desired[i])
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add application owner %s, got error: %s", desired[i], err))
+			return diags
+		}
+		tflog.Trace(ctx, fmt.Sprintf("added application owner %s", desired[i]))
+	}
+
+	for i := 0; i < len(current); i++ {
+		if containsString(desired, current[i]) {
+			continue
+		}
+
+		err = r.client.RemoveApplicationOwner(data.ApplicationObjectID.Value, current[i])
+		if err != nil && !msgraph.IsNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove application owner %s, got error: %s", current[i], err))
+			return diags
+		}
+		tflog.Trace(ctx, fmt.Sprintf("removed application owner %s", current[i]))
+	}
+
+	data.Id = types.String{Value: data.ApplicationObjectID.Value}
+
+	return diags
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AttributeSetResource{}
+var _ resource.ResourceWithImportState = &AttributeSetResource{}
+
+func NewAttributeSetResource() resource.Resource {
+	return &AttributeSetResource{}
+}
+
+// AttributeSetResource defines the resource implementation.
+type AttributeSetResource struct {
+	client *msgraph.Client
+}
+
+// AttributeSetResourceModel describes the resource data model.
+type AttributeSetResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	MaxAttributesPerSet types.Int64  `tfsdk:"max_attributes_per_set"`
+}
+
+func (r *AttributeSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attribute_set"
+}
+
+func (r *AttributeSetResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Attribute set resource, groups custom security attribute definitions. Attribute sets cannot be deleted, destroying the resource only removes it from the state",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Attribute set ID, identical to the name",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"name": {
+				MarkdownDescription: "Name of the attribute set, e.g. `Engineering`. Case insensitive and unique in the tenant",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"description": {
+				MarkdownDescription: "Description",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"max_attributes_per_set": {
+				MarkdownDescription: "Maximum number of attributes in the set, up to 500. Can be increased but not decreased",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.Int64Type,
+			},
+		},
+	}, nil
+}
+
+func (r *AttributeSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AttributeSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AttributeSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateAttributeSet(msgraph.AttributeSet{
+		ID:                  data.Name.Value,
+		Description:         stringPointer(data.Description),
+		MaxAttributesPerSet: int64Pointer(data.MaxAttributesPerSet),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create attribute set, got error: %s", err))
+		return
+	}
+
+	flattenAttributeSet(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created attribute set %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AttributeSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AttributeSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	set, err := r.client.GetAttributeSet(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("attribute set %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read attribute set, got error: %s", err))
+		return
+	}
+
+	flattenAttributeSet(data, set)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AttributeSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AttributeSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.UpdateAttributeSet(msgraph.AttributeSet{
+		ID:                  data.Id.Value,
+		Description:         stringPointer(data.Description),
+		MaxAttributesPerSet: int64Pointer(data.MaxAttributesPerSet),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update attribute set, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetAttributeSet(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read attribute set, got error: %s", err))
+		return
+	}
+
+	flattenAttributeSet(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AttributeSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AttributeSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The graph api does not support deleting attribute sets, the set is
+	// left in place and only dropped from the state.
+	resp.Diagnostics.AddWarning(
+		"Attribute set left in place",
+		fmt.Sprintf("Attribute set %s was not deleted, attribute sets cannot be deleted.", data.Id.Value),
+	)
+}
+
+func (r *AttributeSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+func flattenAttributeSet(data *AttributeSetResourceModel, set *msgraph.AttributeSet) {
+	data.Id = types.String{Value: set.ID}
+	data.Description = optionalStringPointer(set.Description)
+
+	data.MaxAttributesPerSet = types.Int64{Null: true}
+	if set.MaxAttributesPerSet != nil {
+		data.MaxAttributesPerSet = types.Int64{Value: *set.MaxAttributesPerSet}
+	}
+}
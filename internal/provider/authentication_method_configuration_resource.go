@@ -0,0 +1,594 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AuthenticationMethodConfigurationResource{}
+var _ resource.ResourceWithImportState = &AuthenticationMethodConfigurationResource{}
+var _ resource.ResourceWithValidateConfig = &AuthenticationMethodConfigurationResource{}
+
+func NewAuthenticationMethodConfigurationResource() resource.Resource {
+	return &AuthenticationMethodConfigurationResource{}
+}
+
+// AuthenticationMethodConfigurationResource defines the resource implementation.
+type AuthenticationMethodConfigurationResource struct {
+	client *msgraph.Client
+}
+
+// AuthenticationMethodConfigurationResourceModel describes the resource data model.
+type AuthenticationMethodConfigurationResourceModel struct {
+	Id                     types.String                       `tfsdk:"id"`
+	State                  types.String                       `tfsdk:"state"`
+	IncludeTargets         []AuthenticationMethodTargetModel  `tfsdk:"include_targets"`
+	ExcludeTargets         types.Set                          `tfsdk:"exclude_targets"`
+	Fido2                  *Fido2MethodModel                  `tfsdk:"fido2"`
+	MicrosoftAuthenticator *MicrosoftAuthenticatorMethodModel `tfsdk:"microsoft_authenticator"`
+	TemporaryAccessPass    *TemporaryAccessPassMethodModel    `tfsdk:"temporary_access_pass"`
+}
+
+// AuthenticationMethodTargetModel describes a group the method is enabled for.
+type AuthenticationMethodTargetModel struct {
+	ID                 types.String `tfsdk:"id"`
+	AuthenticationMode types.String `tfsdk:"authentication_mode"`
+}
+
+// Fido2MethodModel describes the FIDO2 security key settings.
+type Fido2MethodModel struct {
+	IsSelfServiceRegistrationAllowed types.Bool                 `tfsdk:"is_self_service_registration_allowed"`
+	IsAttestationEnforced            types.Bool                 `tfsdk:"is_attestation_enforced"`
+	KeyRestrictions                  *Fido2KeyRestrictionsModel `tfsdk:"key_restrictions"`
+}
+
+// Fido2KeyRestrictionsModel describes the security key models that are
+// allowed or blocked.
+type Fido2KeyRestrictionsModel struct {
+	IsEnforced      types.Bool   `tfsdk:"is_enforced"`
+	EnforcementType types.String `tfsdk:"enforcement_type"`
+	AaGuids         types.Set    `tfsdk:"aa_guids"`
+}
+
+// MicrosoftAuthenticatorMethodModel describes the Microsoft Authenticator settings.
+type MicrosoftAuthenticatorMethodModel struct {
+	IsSoftwareOathEnabled types.Bool `tfsdk:"is_software_oath_enabled"`
+}
+
+// TemporaryAccessPassMethodModel describes the Temporary Access Pass settings.
+type TemporaryAccessPassMethodModel struct {
+	DefaultLifetimeInMinutes types.Int64 `tfsdk:"default_lifetime_in_minutes"`
+	DefaultLength            types.Int64 `tfsdk:"default_length"`
+	MinimumLifetimeInMinutes types.Int64 `tfsdk:"minimum_lifetime_in_minutes"`
+	MaximumLifetimeInMinutes types.Int64 `tfsdk:"maximum_lifetime_in_minutes"`
+	IsUsableOnce             types.Bool  `tfsdk:"is_usable_once"`
+}
+
+func (r *AuthenticationMethodConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authentication_method_configuration"
+}
+
+func (r *AuthenticationMethodConfigurationResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Authentication method configuration resource, manages a method of the tenant authentication methods policy. Exactly one of `fido2`, `microsoft_authenticator` or `temporary_access_pass` must be set. The configurations cannot be deleted, destroying the resource disables the method",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Method configuration ID, e.g. `Fido2`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"state": {
+				MarkdownDescription: "`enabled` or `disabled`",
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("enabled", "disabled"),
+				},
+				Type: types.StringType,
+			},
+			"include_targets": {
+				MarkdownDescription: "Groups the method is enabled for",
+				Required:            true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "Group Object ID or `all_users`",
+						Required:            true,
+						Type:                types.StringType,
+					},
+					"authentication_mode": {
+						MarkdownDescription: "`push` or `deviceBasedPush`, every mode is allowed when not set. Only valid for `microsoft_authenticator`",
+						Optional:            true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf("push", "deviceBasedPush"),
+						},
+						Type: types.StringType,
+					},
+				}),
+			},
+			"exclude_targets": {
+				MarkdownDescription: "Object IDs of the groups the method is disabled for",
+				Optional:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"fido2": {
+				MarkdownDescription: "FIDO2 security key settings",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					requiresReplaceOnPresenceChange(),
+				},
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"is_self_service_registration_allowed": {
+						MarkdownDescription: "Whether users can register security keys themselves, the current setting is kept when not set",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							resource.UseStateForUnknown(),
+						},
+						Type: types.BoolType,
+					},
+					"is_attestation_enforced": {
+						MarkdownDescription: "Whether the attestation of security keys is verified at registration, the current setting is kept when not set",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							resource.UseStateForUnknown(),
+						},
+						Type: types.BoolType,
+					},
+					"key_restrictions": {
+						MarkdownDescription: "Restricts the security key models by AAGUID, removing the block lifts the restrictions",
+						Optional:            true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"is_enforced": {
+								MarkdownDescription: "Whether the restrictions are enforced",
+								Required:            true,
+								Type:                types.BoolType,
+							},
+							"enforcement_type": {
+								MarkdownDescription: "`allow` or `block`",
+								Required:            true,
+								Validators: []tfsdk.AttributeValidator{
+									stringOneOf("allow", "block"),
+								},
+								Type: types.StringType,
+							},
+							"aa_guids": {
+								MarkdownDescription: "AAGUIDs of the security key models",
+								Required:            true,
+								Type: types.SetType{
+									ElemType: types.StringType,
+								},
+							},
+						}),
+					},
+				}),
+			},
+			"microsoft_authenticator": {
+				MarkdownDescription: "Microsoft Authenticator settings",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					requiresReplaceOnPresenceChange(),
+				},
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"is_software_oath_enabled": {
+						MarkdownDescription: "Whether the app can be used as a software OATH token, the current setting is kept when not set",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							resource.UseStateForUnknown(),
+						},
+						Type: types.BoolType,
+					},
+				}),
+			},
+			"temporary_access_pass": {
+				MarkdownDescription: "Temporary Access Pass settings, the graph api defaults are kept for settings that are not set",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					requiresReplaceOnPresenceChange(),
+				},
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"default_lifetime_in_minutes": {
+						MarkdownDescription: "Default lifetime, between the minimum and maximum lifetime",
+						Optional:            true,
+						Type:                types.Int64Type,
+					},
+					"default_length": {
+						MarkdownDescription: "Default length, between 8 and 48 characters",
+						Optional:            true,
+						Type:                types.Int64Type,
+					},
+					"minimum_lifetime_in_minutes": {
+						MarkdownDescription: "Minimum lifetime, between 10 and 43200 minutes",
+						Optional:            true,
+						Type:                types.Int64Type,
+					},
+					"maximum_lifetime_in_minutes": {
+						MarkdownDescription: "Maximum lifetime, between 10 and 43200 minutes",
+						Optional:            true,
+						Type:                types.Int64Type,
+					},
+					"is_usable_once": {
+						MarkdownDescription: "Whether passes can only be used once by default, the current setting is kept when not set",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							resource.UseStateForUnknown(),
+						},
+						Type: types.BoolType,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r *AuthenticationMethodConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AuthenticationMethodConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	methods := 0
+	for _, set := range []bool{data.Fido2 != nil, data.MicrosoftAuthenticator != nil, data.TemporaryAccessPass != nil} {
+		if set {
+			methods++
+		}
+	}
+	if methods != 1 {
+		resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of fido2, microsoft_authenticator or temporary_access_pass must be set.")
+		return
+	}
+
+	if data.MicrosoftAuthenticator == nil {
+		for i := 0; i < len(data.IncludeTargets); i++ {
+			if !data.IncludeTargets[i].AuthenticationMode.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("include_targets"), "Invalid Attribute Combination", "authentication_mode can only be set for microsoft_authenticator.")
+				break
+			}
+		}
+	}
+
+	if pass := data.TemporaryAccessPass; pass != nil {
+		validateInt64Range(&resp.Diagnostics, path.Root("temporary_access_pass").AtName("default_length"), pass.DefaultLength, 8, 48)
+		validateInt64Range(&resp.Diagnostics, path.Root("temporary_access_pass").AtName("minimum_lifetime_in_minutes"), pass.MinimumLifetimeInMinutes, 10, 43200)
+		validateInt64Range(&resp.Diagnostics, path.Root("temporary_access_pass").AtName("maximum_lifetime_in_minutes"), pass.MaximumLifetimeInMinutes, 10, 43200)
+		validateInt64Range(&resp.Diagnostics, path.Root("temporary_access_pass").AtName("default_lifetime_in_minutes"), pass.DefaultLifetimeInMinutes, 10, 43200)
+
+		minimum, maximum := pass.MinimumLifetimeInMinutes, pass.MaximumLifetimeInMinutes
+		if !minimum.IsNull() && !minimum.IsUnknown() && !maximum.IsNull() && !maximum.IsUnknown() && minimum.Value > maximum.Value {
+			resp.Diagnostics.AddAttributeError(path.Root("temporary_access_pass").AtName("minimum_lifetime_in_minutes"), "Invalid Attribute Value", "minimum_lifetime_in_minutes must not be greater than maximum_lifetime_in_minutes.")
+		}
+	}
+}
+
+// validateInt64Range adds an error when a known number is outside of the
+// inclusive range.
+func validateInt64Range(diags *diag.Diagnostics, attributePath path.Path, value types.Int64, minimum int64, maximum int64) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	if value.Value < minimum || value.Value > maximum {
+		diags.AddAttributeError(attributePath, "Invalid Attribute Value", fmt.Sprintf("%d is not valid, value must be between %d and %d.", value.Value, minimum, maximum))
+	}
+}
+
+func (r *AuthenticationMethodConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AuthenticationMethodConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AuthenticationMethodConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := expandAuthenticationMethodConfiguration(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configurations exist in every tenant, creating the resource
+	// takes over the configuration of the method.
+	r.client.GraphAccess()
+	err := r.client.UpdateAuthenticationMethodConfiguration(configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update authentication method configuration, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetAuthenticationMethodConfiguration(configuration.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authentication method configuration, got error: %s", err))
+		return
+	}
+
+	flattenAuthenticationMethodConfiguration(data, updated)
+	tflog.Trace(ctx, fmt.Sprintf("configured authentication method %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthenticationMethodConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AuthenticationMethodConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	configuration, err := r.client.GetAuthenticationMethodConfiguration(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("authentication method configuration %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authentication method configuration, got error: %s", err))
+		return
+	}
+
+	flattenAuthenticationMethodConfiguration(data, configuration)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthenticationMethodConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AuthenticationMethodConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := expandAuthenticationMethodConfiguration(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.UpdateAuthenticationMethodConfiguration(configuration)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update authentication method configuration, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetAuthenticationMethodConfiguration(configuration.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authentication method configuration, got error: %s", err))
+		return
+	}
+
+	flattenAuthenticationMethodConfiguration(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthenticationMethodConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AuthenticationMethodConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := expandAuthenticationMethodConfiguration(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration cannot be deleted, the method is disabled instead.
+	configuration.State = "disabled"
+
+	r.client.GraphAccess()
+	err := r.client.UpdateAuthenticationMethodConfiguration(configuration)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable authentication method configuration, got error: %s", err))
+		return
+	}
+}
+
+func (r *AuthenticationMethodConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// authenticationMethodType returns the @odata.type of the configured method.
+func authenticationMethodType(data *AuthenticationMethodConfigurationResourceModel) string {
+	switch {
+	case data.Fido2 != nil:
+		return msgraph.AuthenticationMethodTypeFido2
+	case data.MicrosoftAuthenticator != nil:
+		return msgraph.AuthenticationMethodTypeMicrosoftAuthenticator
+	default:
+		return msgraph.AuthenticationMethodTypeTemporaryAccessPass
+	}
+}
+
+func expandAuthenticationMethodConfiguration(ctx context.Context, data *AuthenticationMethodConfigurationResourceModel) (msgraph.AuthenticationMethodConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration := msgraph.AuthenticationMethodConfiguration{
+		Odata_type:     authenticationMethodType(data),
+		State:          data.State.Value,
+		IncludeTargets: make([]msgraph.AuthenticationMethodTarget, 0),
+		ExcludeTargets: make([]msgraph.ExcludeTarget, 0),
+	}
+
+	for i := 0; i < len(data.IncludeTargets); i++ {
+		target := msgraph.AuthenticationMethodTarget{
+			TargetType: "group",
+			ID:         data.IncludeTargets[i].ID.Value,
+		}
+		if data.MicrosoftAuthenticator != nil {
+			target.AuthenticationMode = "any"
+			if !data.IncludeTargets[i].AuthenticationMode.IsNull() {
+				target.AuthenticationMode = data.IncludeTargets[i].AuthenticationMode.Value
+			}
+		}
+		configuration.IncludeTargets = append(configuration.IncludeTargets, target)
+	}
+
+	excluded, d := setToStrings(ctx, data.ExcludeTargets)
+	diags.Append(d...)
+	for i := 0; i < len(excluded); i++ {
+		configuration.ExcludeTargets = append(configuration.ExcludeTargets, msgraph.ExcludeTarget{TargetType: "group", ID: excluded[i]})
+	}
+
+	switch {
+	case data.Fido2 != nil:
+		configuration.ID = msgraph.AuthenticationMethodFido2
+		configuration.IsSelfServiceRegistrationAllowed = boolPointer(data.Fido2.IsSelfServiceRegistrationAllowed)
+		configuration.IsAttestationEnforced = boolPointer(data.Fido2.IsAttestationEnforced)
+		// Restrictions that are not sent are kept by the graph api, they
+		// are lifted explicitly when the block is not configured.
+		configuration.KeyRestrictions = &msgraph.Fido2KeyRestrictions{
+			IsEnforced:      false,
+			EnforcementType: "block",
+			AaGuids:         make([]string, 0),
+		}
+		if restrictions := data.Fido2.KeyRestrictions; restrictions != nil {
+			aaGuids, d := setToStrings(ctx, restrictions.AaGuids)
+			diags.Append(d...)
+			configuration.KeyRestrictions = &msgraph.Fido2KeyRestrictions{
+				IsEnforced:      restrictions.IsEnforced.Value,
+				EnforcementType: restrictions.EnforcementType.Value,
+				AaGuids:         append(make([]string, 0), aaGuids...),
+			}
+		}
+	case data.MicrosoftAuthenticator != nil:
+		configuration.ID = msgraph.AuthenticationMethodMicrosoftAuthenticator
+		configuration.IsSoftwareOathEnabled = boolPointer(data.MicrosoftAuthenticator.IsSoftwareOathEnabled)
+	default:
+		pass := data.TemporaryAccessPass
+		configuration.ID = msgraph.AuthenticationMethodTemporaryAccessPass
+		configuration.DefaultLifetimeInMinutes = int64Pointer(pass.DefaultLifetimeInMinutes)
+		configuration.DefaultLength = int64Pointer(pass.DefaultLength)
+		configuration.MinimumLifetimeInMinutes = int64Pointer(pass.MinimumLifetimeInMinutes)
+		configuration.MaximumLifetimeInMinutes = int64Pointer(pass.MaximumLifetimeInMinutes)
+		configuration.IsUsableOnce = boolPointer(pass.IsUsableOnce)
+	}
+
+	return configuration, diags
+}
+
+// flattenAuthenticationMethodConfiguration updates the model from the graph
+// api, the @odata.type decides which method is set. Method settings the
+// graph api defaults are only tracked when they are configured, flags are
+// always tracked.
+func flattenAuthenticationMethodConfiguration(data *AuthenticationMethodConfigurationResourceModel, configuration *msgraph.AuthenticationMethodConfiguration) {
+	data.Id = types.String{Value: configuration.ID}
+	data.State = types.String{Value: configuration.State}
+
+	data.IncludeTargets = make([]AuthenticationMethodTargetModel, 0)
+	for i := 0; i < len(configuration.IncludeTargets); i++ {
+		target := configuration.IncludeTargets[i]
+		mode := types.String{Null: true}
+		if target.AuthenticationMode != "" && target.AuthenticationMode != "any" {
+			mode = types.String{Value: target.AuthenticationMode}
+		}
+		data.IncludeTargets = append(data.IncludeTargets, AuthenticationMethodTargetModel{
+			ID:                 types.String{Value: target.ID},
+			AuthenticationMode: mode,
+		})
+	}
+
+	excluded := make([]string, 0)
+	for i := 0; i < len(configuration.ExcludeTargets); i++ {
+		excluded = append(excluded, configuration.ExcludeTargets[i].ID)
+	}
+	data.ExcludeTargets = optionalStringsToSet(excluded)
+
+	priorFido2, priorPass := data.Fido2, data.TemporaryAccessPass
+	data.Fido2 = nil
+	data.MicrosoftAuthenticator = nil
+	data.TemporaryAccessPass = nil
+
+	switch configuration.Odata_type {
+	case msgraph.AuthenticationMethodTypeFido2:
+		fido2 := &Fido2MethodModel{
+			IsSelfServiceRegistrationAllowed: computedBool(configuration.IsSelfServiceRegistrationAllowed),
+			IsAttestationEnforced:            computedBool(configuration.IsAttestationEnforced),
+		}
+		if restrictions := configuration.KeyRestrictions; restrictions != nil && ((priorFido2 != nil && priorFido2.KeyRestrictions != nil) || restrictions.IsEnforced || len(restrictions.AaGuids) > 0) {
+			fido2.KeyRestrictions = &Fido2KeyRestrictionsModel{
+				IsEnforced:      types.Bool{Value: restrictions.IsEnforced},
+				EnforcementType: types.String{Value: restrictions.EnforcementType},
+				AaGuids:         stringsToSet(restrictions.AaGuids),
+			}
+		}
+		data.Fido2 = fido2
+	case msgraph.AuthenticationMethodTypeMicrosoftAuthenticator:
+		data.MicrosoftAuthenticator = &MicrosoftAuthenticatorMethodModel{
+			IsSoftwareOathEnabled: computedBool(configuration.IsSoftwareOathEnabled),
+		}
+	case msgraph.AuthenticationMethodTypeTemporaryAccessPass:
+		prior := &TemporaryAccessPassMethodModel{
+			DefaultLifetimeInMinutes: types.Int64{Null: true},
+			DefaultLength:            types.Int64{Null: true},
+			MinimumLifetimeInMinutes: types.Int64{Null: true},
+			MaximumLifetimeInMinutes: types.Int64{Null: true},
+		}
+		if priorPass != nil {
+			prior = priorPass
+		}
+		data.TemporaryAccessPass = &TemporaryAccessPassMethodModel{
+			DefaultLifetimeInMinutes: configuredInt64(configuration.DefaultLifetimeInMinutes, prior.DefaultLifetimeInMinutes),
+			DefaultLength:            configuredInt64(configuration.DefaultLength, prior.DefaultLength),
+			MinimumLifetimeInMinutes: configuredInt64(configuration.MinimumLifetimeInMinutes, prior.MinimumLifetimeInMinutes),
+			MaximumLifetimeInMinutes: configuredInt64(configuration.MaximumLifetimeInMinutes, prior.MaximumLifetimeInMinutes),
+			IsUsableOnce:             computedBool(configuration.IsUsableOnce),
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &AuthenticationStrengthPolicyDataSource{}
+
+func NewAuthenticationStrengthPolicyDataSource() datasource.DataSource {
+	return &AuthenticationStrengthPolicyDataSource{}
+}
+
+// AuthenticationStrengthPolicyDataSource defines the data source implementation.
+type AuthenticationStrengthPolicyDataSource struct {
+	client *msgraph.Client
+}
+
+func (d *AuthenticationStrengthPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authentication_strength_policy"
+}
+
+func (d *AuthenticationStrengthPolicyDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Authentication strength policy data source, looks up exactly one of `id` or `display_name`. Used to reference the built-in policies, e.g. `Phishing-resistant MFA`",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Authentication strength policy ID, the built-in policies have the same ID in every tenant",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"description": {
+				MarkdownDescription: "Description",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"allowed_combinations": {
+				MarkdownDescription: "Authentication method combinations that satisfy the policy",
+				Computed:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"policy_type": {
+				MarkdownDescription: "`builtIn` or `custom`",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"requirements_satisfied": {
+				MarkdownDescription: "Requirements satisfied by the allowed combinations, e.g. `mfa`",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (d *AuthenticationStrengthPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuthenticationStrengthPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// The data source shares the data model of the resource.
+	var data AuthenticationStrengthPolicyResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() == data.DisplayName.IsNull() {
+		resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of id or display_name must be set.")
+		return
+	}
+
+	d.client.GraphAccess()
+
+	var policy *msgraph.AuthenticationStrengthPolicy
+	var err error
+	if !data.Id.IsNull() {
+		policy, err = d.client.GetAuthenticationStrengthPolicy(data.Id.Value)
+	} else {
+		policy, err = d.client.GetAuthenticationStrengthPolicyByDisplayName(data.DisplayName.Value)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authentication strength policy, got error: %s", err))
+		return
+	}
+
+	flattenAuthenticationStrengthPolicy(&data, policy)
+
+	tflog.Trace(ctx, fmt.Sprintf("read authentication strength policy %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &AuthenticationStrengthPolicyResource{}
+var _ resource.ResourceWithImportState = &AuthenticationStrengthPolicyResource{}
+
+func NewAuthenticationStrengthPolicyResource() resource.Resource {
+	return &AuthenticationStrengthPolicyResource{}
+}
+
+// AuthenticationStrengthPolicyResource defines the resource implementation.
+type AuthenticationStrengthPolicyResource struct {
+	client *msgraph.Client
+}
+
+// AuthenticationStrengthPolicyResourceModel describes the resource data model.
+type AuthenticationStrengthPolicyResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	DisplayName           types.String `tfsdk:"display_name"`
+	Description           types.String `tfsdk:"description"`
+	AllowedCombinations   types.Set    `tfsdk:"allowed_combinations"`
+	PolicyType            types.String `tfsdk:"policy_type"`
+	RequirementsSatisfied types.String `tfsdk:"requirements_satisfied"`
+}
+
+func (r *AuthenticationStrengthPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authentication_strength_policy"
+}
+
+func (r *AuthenticationStrengthPolicyResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Authentication strength policy resource, creates a custom authentication strength for conditional access policies",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Authentication strength policy ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"description": {
+				MarkdownDescription: "Description",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"allowed_combinations": {
+				MarkdownDescription: "Authentication method combinations that satisfy the policy, e.g. `fido2` or `password,microsoftAuthenticatorPush`",
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					combinationSetOf(msgraph.AuthenticationMethodModes...),
+				},
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"policy_type": {
+				Computed:            true,
+				MarkdownDescription: "Policy type, always `custom` for policies created by this resource",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"requirements_satisfied": {
+				Computed:            true,
+				MarkdownDescription: "Requirements satisfied by the allowed combinations, e.g. `mfa`",
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *AuthenticationStrengthPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AuthenticationStrengthPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AuthenticationStrengthPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := expandAuthenticationStrengthPolicy(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateAuthenticationStrengthPolicy(policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create authentication strength policy, got error: %s", err))
+		return
+	}
+
+	flattenAuthenticationStrengthPolicy(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created authentication strength policy %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthenticationStrengthPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AuthenticationStrengthPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	policy, err := r.client.GetAuthenticationStrengthPolicy(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("authentication strength policy %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authentication strength policy, got error: %s", err))
+		return
+	}
+
+	flattenAuthenticationStrengthPolicy(data, policy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthenticationStrengthPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AuthenticationStrengthPolicyResourceModel
+	var state *AuthenticationStrengthPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := expandAuthenticationStrengthPolicy(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	policy.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	if !data.DisplayName.Equal(state.DisplayName) || !data.Description.Equal(state.Description) {
+		err := r.client.UpdateAuthenticationStrengthPolicy(policy)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update authentication strength policy, got error: %s", err))
+			return
+		}
+	}
+
+	// The allowed combinations are not part of the PATCH payload and are
+	// replaced with a separate action.
+	if !data.AllowedCombinations.Equal(state.AllowedCombinations) {
+		err := r.client.UpdateAuthenticationStrengthCombinations(policy.ID, policy.AllowedCombinations)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update authentication strength policy combinations, got error: %s", err))
+			return
+		}
+	}
+
+	updated, err := r.client.GetAuthenticationStrengthPolicy(policy.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authentication strength policy, got error: %s", err))
+		return
+	}
+
+	flattenAuthenticationStrengthPolicy(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthenticationStrengthPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AuthenticationStrengthPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteAuthenticationStrengthPolicy(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete authentication strength policy, got error: %s", err))
+		return
+	}
+}
+
+func (r *AuthenticationStrengthPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandAuthenticationStrengthPolicy(ctx context.Context, data *AuthenticationStrengthPolicyResourceModel) (msgraph.AuthenticationStrengthPolicy, diag.Diagnostics) {
+	combinations, diags := setToStrings(ctx, data.AllowedCombinations)
+
+	return msgraph.AuthenticationStrengthPolicy{
+		DisplayName:         data.DisplayName.Value,
+		Description:         stringPointer(data.Description),
+		AllowedCombinations: combinations,
+	}, diags
+}
+
+func flattenAuthenticationStrengthPolicy(data *AuthenticationStrengthPolicyResourceModel, policy *msgraph.AuthenticationStrengthPolicy) {
+	data.Id = types.String{Value: policy.ID}
+	data.DisplayName = types.String{Value: policy.DisplayName}
+	data.Description = optionalStringPointer(policy.Description)
+	data.AllowedCombinations = stringsToSet(policy.AllowedCombinations)
+	data.PolicyType = types.String{Value: policy.PolicyType}
+	data.RequirementsSatisfied = types.String{Value: policy.RequirementsSatisfied}
+}
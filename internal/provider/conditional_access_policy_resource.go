@@ -0,0 +1,674 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ConditionalAccessPolicyResource{}
+var _ resource.ResourceWithImportState = &ConditionalAccessPolicyResource{}
+
+func NewConditionalAccessPolicyResource() resource.Resource {
+	return &ConditionalAccessPolicyResource{}
+}
+
+// ConditionalAccessPolicyResource defines the resource implementation.
+type ConditionalAccessPolicyResource struct {
+	client *msgraph.Client
+}
+
+// ConditionalAccessPolicyResourceModel describes the resource data model.
+type ConditionalAccessPolicyResourceModel struct {
+	Id              types.String                           `tfsdk:"id"`
+	DisplayName     types.String                           `tfsdk:"display_name"`
+	State           types.String                           `tfsdk:"state"`
+	Conditions      *ConditionalAccessConditionsModel      `tfsdk:"conditions"`
+	GrantControls   *ConditionalAccessGrantControlsModel   `tfsdk:"grant_controls"`
+	SessionControls *ConditionalAccessSessionControlsModel `tfsdk:"session_controls"`
+}
+
+// ConditionalAccessConditionsModel describes the conditions of a policy.
+type ConditionalAccessConditionsModel struct {
+	ClientAppTypes   types.Set                           `tfsdk:"client_app_types"`
+	SignInRiskLevels types.Set                           `tfsdk:"sign_in_risk_levels"`
+	UserRiskLevels   types.Set                           `tfsdk:"user_risk_levels"`
+	Users            *ConditionalAccessUsersModel        `tfsdk:"users"`
+	Applications     *ConditionalAccessApplicationsModel `tfsdk:"applications"`
+	Platforms        *ConditionalAccessPlatformsModel    `tfsdk:"platforms"`
+	Locations        *ConditionalAccessLocationsModel    `tfsdk:"locations"`
+}
+
+// ConditionalAccessUsersModel describes the users a policy applies to.
+type ConditionalAccessUsersModel struct {
+	IncludeUsers  types.Set `tfsdk:"include_users"`
+	ExcludeUsers  types.Set `tfsdk:"exclude_users"`
+	IncludeGroups types.Set `tfsdk:"include_groups"`
+	ExcludeGroups types.Set `tfsdk:"exclude_groups"`
+	IncludeRoles  types.Set `tfsdk:"include_roles"`
+	ExcludeRoles  types.Set `tfsdk:"exclude_roles"`
+}
+
+// ConditionalAccessApplicationsModel describes the applications a policy
+// applies to.
+type ConditionalAccessApplicationsModel struct {
+	IncludeApplications types.Set `tfsdk:"include_applications"`
+	ExcludeApplications types.Set `tfsdk:"exclude_applications"`
+	IncludeUserActions  types.Set `tfsdk:"include_user_actions"`
+}
+
+// ConditionalAccessPlatformsModel describes the device platforms a policy
+// applies to.
+type ConditionalAccessPlatformsModel struct {
+	IncludePlatforms types.Set `tfsdk:"include_platforms"`
+	ExcludePlatforms types.Set `tfsdk:"exclude_platforms"`
+}
+
+// ConditionalAccessLocationsModel describes the locations a policy applies
+// to.
+type ConditionalAccessLocationsModel struct {
+	IncludeLocations types.Set `tfsdk:"include_locations"`
+	ExcludeLocations types.Set `tfsdk:"exclude_locations"`
+}
+
+// ConditionalAccessGrantControlsModel describes the grant controls of a
+// policy.
+type ConditionalAccessGrantControlsModel struct {
+	Operator                       types.String `tfsdk:"operator"`
+	BuiltInControls                types.Set    `tfsdk:"built_in_controls"`
+	CustomAuthenticationFactors    types.Set    `tfsdk:"custom_authentication_factors"`
+	TermsOfUse                     types.Set    `tfsdk:"terms_of_use"`
+	AuthenticationStrengthPolicyID types.String `tfsdk:"authentication_strength_policy_id"`
+}
+
+// ConditionalAccessSessionControlsModel describes the session controls of a
+// policy.
+type ConditionalAccessSessionControlsModel struct {
+	ApplicationEnforcedRestrictionsEnabled types.Bool   `tfsdk:"application_enforced_restrictions_enabled"`
+	CloudAppSecurityPolicy                 types.String `tfsdk:"cloud_app_security_policy"`
+	SignInFrequency                        types.Int64  `tfsdk:"sign_in_frequency"`
+	SignInFrequencyPeriod                  types.String `tfsdk:"sign_in_frequency_period"`
+	SignInFrequencyInterval                types.String `tfsdk:"sign_in_frequency_interval"`
+	PersistentBrowserMode                  types.String `tfsdk:"persistent_browser_mode"`
+	DisableResilienceDefaults              types.Bool   `tfsdk:"disable_resilience_defaults"`
+}
+
+func (r *ConditionalAccessPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conditional_access_policy"
+}
+
+// optionalStringSetAttribute describes an optional set of strings, empty
+// sets are stored as null.
+func optionalStringSetAttribute(description string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Type: types.SetType{
+			ElemType: types.StringType,
+		},
+	}
+}
+
+func (r *ConditionalAccessPolicyResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Conditional Access policy resource. Empty sets are not supported, leave the attribute out instead",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Policy ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"state": {
+				MarkdownDescription: "`enabled`, `disabled` or `enabledForReportingButNotEnforced`",
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("enabled", "disabled", "enabledForReportingButNotEnforced"),
+				},
+				Type: types.StringType,
+			},
+			"conditions": {
+				MarkdownDescription: "Conditions the sign-in must match for the policy to apply",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"client_app_types": {
+						MarkdownDescription: "Client application types, e.g. `all`, `browser`, `mobileAppsAndDesktopClients`, `exchangeActiveSync` or `other`",
+						Required:            true,
+						Type: types.SetType{
+							ElemType: types.StringType,
+						},
+					},
+					"sign_in_risk_levels": optionalStringSetAttribute("Sign-in risk levels, `low`, `medium`, `high` or `none`"),
+					"user_risk_levels":    optionalStringSetAttribute("User risk levels, `low`, `medium`, `high` or `none`"),
+					"users": {
+						MarkdownDescription: "Users, groups and roles the policy applies to",
+						Required:            true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"include_users":  optionalStringSetAttribute("User Object IDs, `All`, `None` or `GuestsOrExternalUsers`"),
+							"exclude_users":  optionalStringSetAttribute("User Object IDs or `GuestsOrExternalUsers`"),
+							"include_groups": optionalStringSetAttribute("Group Object IDs"),
+							"exclude_groups": optionalStringSetAttribute("Group Object IDs"),
+							"include_roles":  optionalStringSetAttribute("Directory role template IDs"),
+							"exclude_roles":  optionalStringSetAttribute("Directory role template IDs"),
+						}),
+					},
+					"applications": {
+						MarkdownDescription: "Applications and user actions the policy applies to",
+						Required:            true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"include_applications": optionalStringSetAttribute("Application IDs, `All`, `None` or `Office365`"),
+							"exclude_applications": optionalStringSetAttribute("Application IDs or `Office365`"),
+							"include_user_actions": optionalStringSetAttribute("User actions, `urn:user:registersecurityinfo` or `urn:user:registerdevice`"),
+						}),
+					},
+					"platforms": {
+						MarkdownDescription: "Device platforms the policy applies to",
+						Optional:            true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"include_platforms": optionalStringSetAttribute("Platforms, e.g. `all`, `android`, `iOS`, `windows`, `macOS` or `linux`"),
+							"exclude_platforms": optionalStringSetAttribute("Platforms, e.g. `android`, `iOS`, `windows`, `macOS` or `linux`"),
+						}),
+					},
+					"locations": {
+						MarkdownDescription: "Locations the policy applies to",
+						Optional:            true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"include_locations": optionalStringSetAttribute("Named location IDs, `All` or `AllTrusted`"),
+							"exclude_locations": optionalStringSetAttribute("Named location IDs or `AllTrusted`"),
+						}),
+					},
+				}),
+			},
+			"grant_controls": {
+				MarkdownDescription: "Controls the user must satisfy to be granted access",
+				Optional:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"operator": {
+						MarkdownDescription: "`AND` or `OR`",
+						Required:            true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf("AND", "OR"),
+						},
+						Type: types.StringType,
+					},
+					"built_in_controls":             optionalStringSetAttribute("Built-in controls, e.g. `block`, `mfa`, `compliantDevice`, `domainJoinedDevice`, `approvedApplication`, `compliantApplication` or `passwordChange`"),
+					"custom_authentication_factors": optionalStringSetAttribute("Custom authentication factors"),
+					"terms_of_use":                  optionalStringSetAttribute("Terms of use IDs"),
+					"authentication_strength_policy_id": {
+						MarkdownDescription: "Authentication strength policy ID, see the `msgraph_authentication_strength_policy` resource and data source",
+						Optional:            true,
+						Type:                types.StringType,
+					},
+				}),
+			},
+			"session_controls": {
+				MarkdownDescription: "Controls applied to the session once access is granted",
+				Optional:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"application_enforced_restrictions_enabled": {
+						MarkdownDescription: "Whether application enforced restrictions are enabled",
+						Optional:            true,
+						Type:                types.BoolType,
+					},
+					"cloud_app_security_policy": {
+						MarkdownDescription: "Defender for Cloud Apps session policy, `mcasConfigured`, `monitorOnly` or `blockDownloads`",
+						Optional:            true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf("mcasConfigured", "monitorOnly", "blockDownloads"),
+						},
+						Type: types.StringType,
+					},
+					"sign_in_frequency": {
+						MarkdownDescription: "Number of hours or days after which the user must sign in again, requires `sign_in_frequency_period`",
+						Optional:            true,
+						Type:                types.Int64Type,
+					},
+					"sign_in_frequency_period": {
+						MarkdownDescription: "`hours` or `days`",
+						Optional:            true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf("hours", "days"),
+						},
+						Type: types.StringType,
+					},
+					"sign_in_frequency_interval": {
+						MarkdownDescription: "`timeBased` or `everyTime`, defaults to `timeBased` when `sign_in_frequency` is set",
+						Optional:            true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf("timeBased", "everyTime"),
+						},
+						Type: types.StringType,
+					},
+					"persistent_browser_mode": {
+						MarkdownDescription: "`always` or `never`",
+						Optional:            true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf("always", "never"),
+						},
+						Type: types.StringType,
+					},
+					"disable_resilience_defaults": {
+						MarkdownDescription: "Whether resilience defaults are disabled",
+						Optional:            true,
+						Type:                types.BoolType,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r *ConditionalAccessPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConditionalAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ConditionalAccessPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := expandConditionalAccessPolicy(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateConditionalAccessPolicy(policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create conditional access policy, got error: %s", err))
+		return
+	}
+
+	flattenConditionalAccessPolicy(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created conditional access policy %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConditionalAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ConditionalAccessPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	policy, err := r.client.GetConditionalAccessPolicy(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("conditional access policy %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read conditional access policy, got error: %s", err))
+		return
+	}
+
+	flattenConditionalAccessPolicy(data, policy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConditionalAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ConditionalAccessPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := expandConditionalAccessPolicy(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	policy.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateConditionalAccessPolicy(policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update conditional access policy, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetConditionalAccessPolicy(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read conditional access policy, got error: %s", err))
+		return
+	}
+
+	flattenConditionalAccessPolicy(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConditionalAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ConditionalAccessPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteConditionalAccessPolicy(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete conditional access policy, got error: %s", err))
+		return
+	}
+}
+
+func (r *ConditionalAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// stringSets converts several terraform sets of strings at once, the
+// returned slices follow the order of sets.
+func stringSets(ctx context.Context, sets ...types.Set) ([][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([][]string, 0)
+	for i := 0; i < len(sets); i++ {
+		value, d := setToStrings(ctx, sets[i])
+		diags.Append(d...)
+		values = append(values, value)
+	}
+
+	return values, diags
+}
+
+func expandConditionalAccessPolicy(ctx context.Context, data *ConditionalAccessPolicyResourceModel) (msgraph.ConditionalAccessPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy := msgraph.ConditionalAccessPolicy{
+		DisplayName: data.DisplayName.Value,
+		State:       data.State.Value,
+	}
+
+	conditions := data.Conditions
+	values, d := stringSets(ctx, conditions.ClientAppTypes, conditions.SignInRiskLevels, conditions.UserRiskLevels)
+	diags.Append(d...)
+	policy.Conditions.ClientAppTypes = values[0]
+	policy.Conditions.SignInRiskLevels = values[1]
+	policy.Conditions.UserRiskLevels = values[2]
+
+	if conditions.Users != nil {
+		users := conditions.Users
+		values, d = stringSets(ctx, users.IncludeUsers, users.ExcludeUsers, users.IncludeGroups, users.ExcludeGroups, users.IncludeRoles, users.ExcludeRoles)
+		diags.Append(d...)
+		policy.Conditions.Users = msgraph.ConditionalAccessUsers{
+			IncludeUsers:  values[0],
+			ExcludeUsers:  values[1],
+			IncludeGroups: values[2],
+			ExcludeGroups: values[3],
+			IncludeRoles:  values[4],
+			ExcludeRoles:  values[5],
+		}
+	}
+
+	if conditions.Applications != nil {
+		applications := conditions.Applications
+		values, d = stringSets(ctx, applications.IncludeApplications, applications.ExcludeApplications, applications.IncludeUserActions)
+		diags.Append(d...)
+		policy.Conditions.Applications = msgraph.ConditionalAccessApplications{
+			IncludeApplications: values[0],
+			ExcludeApplications: values[1],
+			IncludeUserActions:  values[2],
+		}
+	}
+
+	if conditions.Platforms != nil {
+		values, d = stringSets(ctx, conditions.Platforms.IncludePlatforms, conditions.Platforms.ExcludePlatforms)
+		diags.Append(d...)
+		policy.Conditions.Platforms = &msgraph.ConditionalAccessPlatforms{
+			IncludePlatforms: values[0],
+			ExcludePlatforms: values[1],
+		}
+	}
+
+	if conditions.Locations != nil {
+		values, d = stringSets(ctx, conditions.Locations.IncludeLocations, conditions.Locations.ExcludeLocations)
+		diags.Append(d...)
+		policy.Conditions.Locations = &msgraph.ConditionalAccessLocations{
+			IncludeLocations: values[0],
+			ExcludeLocations: values[1],
+		}
+	}
+
+	if data.GrantControls != nil {
+		grant := data.GrantControls
+		values, d = stringSets(ctx, grant.BuiltInControls, grant.CustomAuthenticationFactors, grant.TermsOfUse)
+		diags.Append(d...)
+		policy.GrantControls = &msgraph.ConditionalAccessGrantControls{
+			Operator:                    grant.Operator.Value,
+			BuiltInControls:             values[0],
+			CustomAuthenticationFactors: values[1],
+			TermsOfUse:                  values[2],
+		}
+		if !grant.AuthenticationStrengthPolicyID.IsNull() {
+			policy.GrantControls.AuthenticationStrength = &msgraph.AuthenticationStrength{ID: grant.AuthenticationStrengthPolicyID.Value}
+		}
+	}
+
+	if data.SessionControls != nil {
+		policy.SessionControls = expandConditionalAccessSessionControls(data.SessionControls)
+	}
+
+	return policy, diags
+}
+
+func expandConditionalAccessSessionControls(data *ConditionalAccessSessionControlsModel) *msgraph.ConditionalAccessSessionControls {
+	controls := &msgraph.ConditionalAccessSessionControls{}
+
+	if !data.ApplicationEnforcedRestrictionsEnabled.IsNull() {
+		controls.ApplicationEnforcedRestrictions = &msgraph.SessionControl{IsEnabled: data.ApplicationEnforcedRestrictionsEnabled.Value}
+	}
+	if !data.CloudAppSecurityPolicy.IsNull() {
+		controls.CloudAppSecurity = &msgraph.CloudAppSecuritySessionControl{IsEnabled: true, CloudAppSecurityType: data.CloudAppSecurityPolicy.Value}
+	}
+	if !data.SignInFrequency.IsNull() || !data.SignInFrequencyInterval.IsNull() {
+		interval := "timeBased"
+		if !data.SignInFrequencyInterval.IsNull() {
+			interval = data.SignInFrequencyInterval.Value
+		}
+		controls.SignInFrequency = &msgraph.SignInFrequencySessionControl{
+			IsEnabled:         true,
+			Type:              stringPointer(data.SignInFrequencyPeriod),
+			FrequencyInterval: interval,
+		}
+		if !data.SignInFrequency.IsNull() {
+			controls.SignInFrequency.Value = &data.SignInFrequency.Value
+		}
+	}
+	if !data.PersistentBrowserMode.IsNull() {
+		controls.PersistentBrowser = &msgraph.PersistentBrowserSessionControl{IsEnabled: true, Mode: data.PersistentBrowserMode.Value}
+	}
+	if !data.DisableResilienceDefaults.IsNull() {
+		controls.DisableResilienceDefaults = &data.DisableResilienceDefaults.Value
+	}
+
+	return controls
+}
+
+// flattenConditionalAccessPolicy updates the model from the graph api. Empty
+// collections and disabled controls are stored as null so policies round
+// trip without differences, empty platforms and locations are kept when
+// they are configured.
+func flattenConditionalAccessPolicy(data *ConditionalAccessPolicyResourceModel, policy *msgraph.ConditionalAccessPolicy) {
+	var priorPlatforms *ConditionalAccessPlatformsModel
+	var priorLocations *ConditionalAccessLocationsModel
+	if data.Conditions != nil {
+		priorPlatforms, priorLocations = data.Conditions.Platforms, data.Conditions.Locations
+	}
+
+	data.Id = types.String{Value: policy.ID}
+	data.DisplayName = types.String{Value: policy.DisplayName}
+	data.State = types.String{Value: policy.State}
+
+	conditions := policy.Conditions
+	data.Conditions = &ConditionalAccessConditionsModel{
+		ClientAppTypes:   optionalStringsToSet(conditions.ClientAppTypes),
+		SignInRiskLevels: optionalStringsToSet(conditions.SignInRiskLevels),
+		UserRiskLevels:   optionalStringsToSet(conditions.UserRiskLevels),
+		Users: &ConditionalAccessUsersModel{
+			IncludeUsers:  optionalStringsToSet(conditions.Users.IncludeUsers),
+			ExcludeUsers:  optionalStringsToSet(conditions.Users.ExcludeUsers),
+			IncludeGroups: optionalStringsToSet(conditions.Users.IncludeGroups),
+			ExcludeGroups: optionalStringsToSet(conditions.Users.ExcludeGroups),
+			IncludeRoles:  optionalStringsToSet(conditions.Users.IncludeRoles),
+			ExcludeRoles:  optionalStringsToSet(conditions.Users.ExcludeRoles),
+		},
+		Applications: &ConditionalAccessApplicationsModel{
+			IncludeApplications: optionalStringsToSet(conditions.Applications.IncludeApplications),
+			ExcludeApplications: optionalStringsToSet(conditions.Applications.ExcludeApplications),
+			IncludeUserActions:  optionalStringsToSet(conditions.Applications.IncludeUserActions),
+		},
+	}
+	platforms := conditions.Platforms
+	if platforms == nil {
+		platforms = &msgraph.ConditionalAccessPlatforms{}
+	}
+	if priorPlatforms != nil || len(platforms.IncludePlatforms)+len(platforms.ExcludePlatforms) > 0 {
+		data.Conditions.Platforms = &ConditionalAccessPlatformsModel{
+			IncludePlatforms: optionalStringsToSet(platforms.IncludePlatforms),
+			ExcludePlatforms: optionalStringsToSet(platforms.ExcludePlatforms),
+		}
+	}
+	locations := conditions.Locations
+	if locations == nil {
+		locations = &msgraph.ConditionalAccessLocations{}
+	}
+	if priorLocations != nil || len(locations.IncludeLocations)+len(locations.ExcludeLocations) > 0 {
+		data.Conditions.Locations = &ConditionalAccessLocationsModel{
+			IncludeLocations: optionalStringsToSet(locations.IncludeLocations),
+			ExcludeLocations: optionalStringsToSet(locations.ExcludeLocations),
+		}
+	}
+
+	data.GrantControls = nil
+	if grant := policy.GrantControls; grant != nil {
+		data.GrantControls = &ConditionalAccessGrantControlsModel{
+			Operator:                       types.String{Value: grant.Operator},
+			BuiltInControls:                optionalStringsToSet(grant.BuiltInControls),
+			CustomAuthenticationFactors:    optionalStringsToSet(grant.CustomAuthenticationFactors),
+			TermsOfUse:                     optionalStringsToSet(grant.TermsOfUse),
+			AuthenticationStrengthPolicyID: types.String{Null: true},
+		}
+		if grant.AuthenticationStrength != nil {
+			data.GrantControls.AuthenticationStrengthPolicyID = optionalString(grant.AuthenticationStrength.ID)
+		}
+	}
+
+	data.SessionControls = flattenConditionalAccessSessionControls(data.SessionControls, policy.SessionControls)
+}
+
+// flattenConditionalAccessSessionControls converts the session controls,
+// values the graph api reports as defaults are kept as configured in prior.
+func flattenConditionalAccessSessionControls(prior *ConditionalAccessSessionControlsModel, controls *msgraph.ConditionalAccessSessionControls) *ConditionalAccessSessionControlsModel {
+	if prior == nil {
+		prior = &ConditionalAccessSessionControlsModel{
+			ApplicationEnforcedRestrictionsEnabled: types.Bool{Null: true},
+			SignInFrequencyInterval:                types.String{Null: true},
+			DisableResilienceDefaults:              types.Bool{Null: true},
+		}
+	}
+	if controls == nil {
+		controls = &msgraph.ConditionalAccessSessionControls{}
+	}
+
+	data := &ConditionalAccessSessionControlsModel{
+		ApplicationEnforcedRestrictionsEnabled: types.Bool{Null: true},
+		CloudAppSecurityPolicy:                 types.String{Null: true},
+		SignInFrequency:                        types.Int64{Null: true},
+		SignInFrequencyPeriod:                  types.String{Null: true},
+		SignInFrequencyInterval:                types.String{Null: true},
+		PersistentBrowserMode:                  types.String{Null: true},
+		DisableResilienceDefaults:              types.Bool{Null: true},
+	}
+	empty := true
+
+	if controls.ApplicationEnforcedRestrictions != nil && controls.ApplicationEnforcedRestrictions.IsEnabled {
+		data.ApplicationEnforcedRestrictionsEnabled = types.Bool{Value: true}
+		empty = false
+	} else if prior.ApplicationEnforcedRestrictionsEnabled.Equal(types.Bool{Value: false}) {
+		data.ApplicationEnforcedRestrictionsEnabled = types.Bool{Value: false}
+		empty = false
+	}
+	if controls.CloudAppSecurity != nil && controls.CloudAppSecurity.IsEnabled {
+		data.CloudAppSecurityPolicy = optionalString(controls.CloudAppSecurity.CloudAppSecurityType)
+		empty = false
+	}
+	if controls.SignInFrequency != nil && controls.SignInFrequency.IsEnabled {
+		data.SignInFrequencyPeriod = optionalStringPointer(controls.SignInFrequency.Type)
+		data.SignInFrequencyInterval = optionalString(controls.SignInFrequency.FrequencyInterval)
+		if prior.SignInFrequencyInterval.IsNull() && controls.SignInFrequency.FrequencyInterval == "timeBased" {
+			data.SignInFrequencyInterval = types.String{Null: true}
+		}
+		if controls.SignInFrequency.Value != nil {
+			data.SignInFrequency = types.Int64{Value: *controls.SignInFrequency.Value}
+		}
+		empty = false
+	}
+	if controls.PersistentBrowser != nil && controls.PersistentBrowser.IsEnabled {
+		data.PersistentBrowserMode = optionalString(controls.PersistentBrowser.Mode)
+		empty = false
+	}
+	if controls.DisableResilienceDefaults != nil && (*controls.DisableResilienceDefaults || !prior.DisableResilienceDefaults.IsNull()) {
+		data.DisableResilienceDefaults = types.Bool{Value: *controls.DisableResilienceDefaults}
+		empty = false
+	}
+
+	if empty {
+		return nil
+	}
+	return data
+}
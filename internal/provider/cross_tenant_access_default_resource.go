@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CrossTenantAccessDefaultResource{}
+var _ resource.ResourceWithImportState = &CrossTenantAccessDefaultResource{}
+
+func NewCrossTenantAccessDefaultResource() resource.Resource {
+	return &CrossTenantAccessDefaultResource{}
+}
+
+// crossTenantAccessDefaultID is the ID of the default configuration, it
+// exists once per tenant.
+const crossTenantAccessDefaultID = "default"
+
+// CrossTenantAccessDefaultResource defines the resource implementation.
+type CrossTenantAccessDefaultResource struct {
+	client *msgraph.Client
+}
+
+// CrossTenantAccessDefaultResourceModel describes the resource data model.
+type CrossTenantAccessDefaultResourceModel struct {
+	Id                       types.String                      `tfsdk:"id"`
+	IsServiceDefault         types.Bool                        `tfsdk:"is_service_default"`
+	InboundTrust             *CrossTenantInboundTrustModel     `tfsdk:"inbound_trust"`
+	B2BCollaborationInbound  *CrossTenantAccessB2BSettingModel `tfsdk:"b2b_collaboration_inbound"`
+	B2BCollaborationOutbound *CrossTenantAccessB2BSettingModel `tfsdk:"b2b_collaboration_outbound"`
+	B2BDirectConnectInbound  *CrossTenantAccessB2BSettingModel `tfsdk:"b2b_direct_connect_inbound"`
+	B2BDirectConnectOutbound *CrossTenantAccessB2BSettingModel `tfsdk:"b2b_direct_connect_outbound"`
+}
+
+func (r *CrossTenantAccessDefaultResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cross_tenant_access_default"
+}
+
+func (r *CrossTenantAccessDefaultResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := crossTenantAccessSettingsAttributes("the current setting is kept")
+	attributes["id"] = tfsdk.Attribute{
+		Computed:            true,
+		MarkdownDescription: "Always `default`",
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			resource.UseStateForUnknown(),
+		},
+		Type: types.StringType,
+	}
+	attributes["is_service_default"] = tfsdk.Attribute{
+		Computed:            true,
+		MarkdownDescription: "Whether the configuration still has the system defaults",
+		Type:                types.BoolType,
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cross-tenant access default resource, manages the settings applied to every tenant without a partner configuration. The configuration cannot be deleted, destroying the resource resets it to the system defaults",
+
+		Attributes: attributes,
+	}, nil
+}
+
+func (r *CrossTenantAccessDefaultResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CrossTenantAccessDefaultResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CrossTenantAccessDefaultResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default configuration exists in every tenant, creating the
+	// resource takes over the configured settings.
+	r.client.GraphAccess()
+	updated, err := r.updateDefault(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cross-tenant access default, got error: %s", err))
+		return
+	}
+
+	flattenCrossTenantAccessDefault(data, updated)
+	tflog.Trace(ctx, "configured cross-tenant access default")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CrossTenantAccessDefaultResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CrossTenantAccessDefaultResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	configuration, err := r.client.GetCrossTenantAccessPolicyDefault()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cross-tenant access default, got error: %s", err))
+		return
+	}
+
+	flattenCrossTenantAccessDefault(data, configuration)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CrossTenantAccessDefaultResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CrossTenantAccessDefaultResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	updated, err := r.updateDefault(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cross-tenant access default, got error: %s", err))
+		return
+	}
+
+	flattenCrossTenantAccessDefault(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CrossTenantAccessDefaultResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The default configuration cannot be deleted, it is reset instead.
+	r.client.GraphAccess()
+	err := r.client.ResetCrossTenantAccessPolicyDefault()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset cross-tenant access default, got error: %s", err))
+		return
+	}
+}
+
+func (r *CrossTenantAccessDefaultResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != crossTenantAccessDefaultID {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import ID %q, got %q.", crossTenantAccessDefaultID, req.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateDefault patches the configured settings, settings that are not set
+// are sent as currently configured because the default configuration has
+// no inheritance. The updated configuration is returned.
+func (r *CrossTenantAccessDefaultResource) updateDefault(data *CrossTenantAccessDefaultResourceModel) (*msgraph.CrossTenantAccessPolicyConfiguration, error) {
+	configuration, err := r.client.GetCrossTenantAccessPolicyDefault()
+	if err != nil {
+		return nil, err
+	}
+
+	if data.InboundTrust != nil {
+		configuration.InboundTrust = expandCrossTenantInboundTrust(data.InboundTrust)
+	}
+	if data.B2BCollaborationInbound != nil {
+		configuration.B2BCollaborationInbound = expandCrossTenantAccessB2BSetting(data.B2BCollaborationInbound)
+	}
+	if data.B2BCollaborationOutbound != nil {
+		configuration.B2BCollaborationOutbound = expandCrossTenantAccessB2BSetting(data.B2BCollaborationOutbound)
+	}
+	if data.B2BDirectConnectInbound != nil {
+		configuration.B2BDirectConnectInbound = expandCrossTenantAccessB2BSetting(data.B2BDirectConnectInbound)
+	}
+	if data.B2BDirectConnectOutbound != nil {
+		configuration.B2BDirectConnectOutbound = expandCrossTenantAccessB2BSetting(data.B2BDirectConnectOutbound)
+	}
+
+	err = r.client.UpdateCrossTenantAccessPolicyDefault(*configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.GetCrossTenantAccessPolicyDefault()
+}
+
+// flattenCrossTenantAccessDefault updates the model from the graph api,
+// only the settings that are configured are tracked.
+func flattenCrossTenantAccessDefault(data *CrossTenantAccessDefaultResourceModel, configuration *msgraph.CrossTenantAccessPolicyConfiguration) {
+	data.Id = types.String{Value: crossTenantAccessDefaultID}
+
+	data.IsServiceDefault = types.Bool{Null: true}
+	if configuration.IsServiceDefault != nil {
+		data.IsServiceDefault = types.Bool{Value: *configuration.IsServiceDefault}
+	}
+
+	if data.InboundTrust != nil {
+		data.InboundTrust = flattenCrossTenantInboundTrust(configuration.InboundTrust, data.InboundTrust)
+	}
+	if data.B2BCollaborationInbound != nil {
+		data.B2BCollaborationInbound = flattenCrossTenantAccessB2BSetting(configuration.B2BCollaborationInbound)
+	}
+	if data.B2BCollaborationOutbound != nil {
+		data.B2BCollaborationOutbound = flattenCrossTenantAccessB2BSetting(configuration.B2BCollaborationOutbound)
+	}
+	if data.B2BDirectConnectInbound != nil {
+		data.B2BDirectConnectInbound = flattenCrossTenantAccessB2BSetting(configuration.B2BDirectConnectInbound)
+	}
+	if data.B2BDirectConnectOutbound != nil {
+		data.B2BDirectConnectOutbound = flattenCrossTenantAccessB2BSetting(configuration.B2BDirectConnectOutbound)
+	}
+}
@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CrossTenantAccessPartnerResource{}
+var _ resource.ResourceWithImportState = &CrossTenantAccessPartnerResource{}
+
+func NewCrossTenantAccessPartnerResource() resource.Resource {
+	return &CrossTenantAccessPartnerResource{}
+}
+
+// CrossTenantAccessPartnerResource defines the resource implementation.
+type CrossTenantAccessPartnerResource struct {
+	client *msgraph.Client
+}
+
+// CrossTenantAccessPartnerResourceModel describes the resource data model.
+type CrossTenantAccessPartnerResourceModel struct {
+	Id                       types.String                      `tfsdk:"id"`
+	TenantID                 types.String                      `tfsdk:"tenant_id"`
+	InboundTrust             *CrossTenantInboundTrustModel     `tfsdk:"inbound_trust"`
+	B2BCollaborationInbound  *CrossTenantAccessB2BSettingModel `tfsdk:"b2b_collaboration_inbound"`
+	B2BCollaborationOutbound *CrossTenantAccessB2BSettingModel `tfsdk:"b2b_collaboration_outbound"`
+	B2BDirectConnectInbound  *CrossTenantAccessB2BSettingModel `tfsdk:"b2b_direct_connect_inbound"`
+	B2BDirectConnectOutbound *CrossTenantAccessB2BSettingModel `tfsdk:"b2b_direct_connect_outbound"`
+}
+
+// CrossTenantInboundTrustModel describes which claims of the other tenant
+// are trusted by conditional access.
+type CrossTenantInboundTrustModel struct {
+	IsMfaAccepted                       types.Bool `tfsdk:"is_mfa_accepted"`
+	IsCompliantDeviceAccepted           types.Bool `tfsdk:"is_compliant_device_accepted"`
+	IsHybridAzureADJoinedDeviceAccepted types.Bool `tfsdk:"is_hybrid_azure_ad_joined_device_accepted"`
+}
+
+// CrossTenantAccessB2BSettingModel describes the users, groups and
+// applications a B2B collaboration or direct connect setting applies to.
+type CrossTenantAccessB2BSettingModel struct {
+	UsersAndGroups *CrossTenantAccessTargetConfigurationModel `tfsdk:"users_and_groups"`
+	Applications   *CrossTenantAccessTargetConfigurationModel `tfsdk:"applications"`
+}
+
+// CrossTenantAccessTargetConfigurationModel describes whether the targets
+// are allowed or blocked.
+type CrossTenantAccessTargetConfigurationModel struct {
+	AccessType types.String                   `tfsdk:"access_type"`
+	Targets    []CrossTenantAccessTargetModel `tfsdk:"targets"`
+}
+
+// CrossTenantAccessTargetModel describes a user, group or application.
+type CrossTenantAccessTargetModel struct {
+	Target     types.String `tfsdk:"target"`
+	TargetType types.String `tfsdk:"target_type"`
+}
+
+func (r *CrossTenantAccessPartnerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cross_tenant_access_partner"
+}
+
+func (r *CrossTenantAccessPartnerResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := crossTenantAccessSettingsAttributes("inherited from the default configuration")
+	attributes["id"] = tfsdk.Attribute{
+		Computed:            true,
+		MarkdownDescription: "Partner configuration ID, identical to the tenant ID",
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			resource.UseStateForUnknown(),
+		},
+		Type: types.StringType,
+	}
+	attributes["tenant_id"] = tfsdk.Attribute{
+		MarkdownDescription: "Tenant ID of the partner",
+		Required:            true,
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			resource.RequiresReplace(),
+		},
+		Type: types.StringType,
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cross-tenant access partner resource, overrides the default cross-tenant access settings for a partner tenant",
+
+		Attributes: attributes,
+	}, nil
+}
+
+// crossTenantAccessSettingsAttributes returns the settings shared by the
+// default and the partner configurations, unset describes the behaviour of
+// settings that are not set.
+func crossTenantAccessSettingsAttributes(unset string) map[string]tfsdk.Attribute {
+	targetConfiguration := func(description string, targetDescription string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			MarkdownDescription: description,
+			Required:            true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"access_type": {
+					MarkdownDescription: "`allowed` or `blocked`",
+					Required:            true,
+					Validators: []tfsdk.AttributeValidator{
+						stringOneOf("allowed", "blocked"),
+					},
+					Type: types.StringType,
+				},
+				"targets": {
+					MarkdownDescription: "Targets that are allowed or blocked",
+					Required:            true,
+					Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+						"target": {
+							MarkdownDescription: targetDescription,
+							Required:            true,
+							Type:                types.StringType,
+						},
+						"target_type": {
+							MarkdownDescription: "`user`, `group` or `application`",
+							Required:            true,
+							Validators: []tfsdk.AttributeValidator{
+								stringOneOf("user", "group", "application"),
+							},
+							Type: types.StringType,
+						},
+					}),
+				},
+			}),
+		}
+	}
+	b2bSetting := func(description string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			MarkdownDescription: fmt.Sprintf("%s, %s when not set", description, unset),
+			Optional:            true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"users_and_groups": targetConfiguration("Users and groups", "Object ID of the user or group, or `AllUsers`"),
+				"applications":     targetConfiguration("Applications", "Application ID, `AllApplications` or `Office365`"),
+			}),
+		}
+	}
+
+	return map[string]tfsdk.Attribute{
+		"inbound_trust": {
+			MarkdownDescription: fmt.Sprintf("Claims of the other tenant trusted by conditional access, %s when not set", unset),
+			Optional:            true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"is_mfa_accepted": {
+					MarkdownDescription: "Whether MFA performed in the other tenant is accepted",
+					Optional:            true,
+					Type:                types.BoolType,
+				},
+				"is_compliant_device_accepted": {
+					MarkdownDescription: "Whether compliant devices of the other tenant are accepted",
+					Optional:            true,
+					Type:                types.BoolType,
+				},
+				"is_hybrid_azure_ad_joined_device_accepted": {
+					MarkdownDescription: "Whether hybrid Azure AD joined devices of the other tenant are accepted",
+					Optional:            true,
+					Type:                types.BoolType,
+				},
+			}),
+		},
+		"b2b_collaboration_inbound":   b2bSetting("Guest access of users of the other tenant"),
+		"b2b_collaboration_outbound":  b2bSetting("Guest access of own users in the other tenant"),
+		"b2b_direct_connect_inbound":  b2bSetting("B2B direct connect access of users of the other tenant, e.g. Teams shared channels"),
+		"b2b_direct_connect_outbound": b2bSetting("B2B direct connect access of own users in the other tenant"),
+	}
+}
+
+func (r *CrossTenantAccessPartnerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CrossTenantAccessPartnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CrossTenantAccessPartnerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateCrossTenantAccessPolicyPartner(expandCrossTenantAccessPartner(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cross-tenant access partner, got error: %s", err))
+		return
+	}
+
+	flattenCrossTenantAccessPartner(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created cross-tenant access partner %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CrossTenantAccessPartnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CrossTenantAccessPartnerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	configuration, err := r.client.GetCrossTenantAccessPolicyPartner(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("cross-tenant access partner %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cross-tenant access partner, got error: %s", err))
+		return
+	}
+
+	flattenCrossTenantAccessPartner(data, configuration)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CrossTenantAccessPartnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CrossTenantAccessPartnerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.UpdateCrossTenantAccessPolicyPartner(expandCrossTenantAccessPartner(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cross-tenant access partner, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetCrossTenantAccessPolicyPartner(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cross-tenant access partner, got error: %s", err))
+		return
+	}
+
+	flattenCrossTenantAccessPartner(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CrossTenantAccessPartnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CrossTenantAccessPartnerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteCrossTenantAccessPolicyPartner(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cross-tenant access partner, got error: %s", err))
+		return
+	}
+}
+
+func (r *CrossTenantAccessPartnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandCrossTenantAccessPartner(data *CrossTenantAccessPartnerResourceModel) msgraph.CrossTenantAccessPolicyConfiguration {
+	return msgraph.CrossTenantAccessPolicyConfiguration{
+		TenantID:                 data.TenantID.Value,
+		InboundTrust:             expandCrossTenantInboundTrust(data.InboundTrust),
+		B2BCollaborationInbound:  expandCrossTenantAccessB2BSetting(data.B2BCollaborationInbound),
+		B2BCollaborationOutbound: expandCrossTenantAccessB2BSetting(data.B2BCollaborationOutbound),
+		B2BDirectConnectInbound:  expandCrossTenantAccessB2BSetting(data.B2BDirectConnectInbound),
+		B2BDirectConnectOutbound: expandCrossTenantAccessB2BSetting(data.B2BDirectConnectOutbound),
+	}
+}
+
+// flattenCrossTenantAccessPartner updates the model from the graph api,
+// settings the partner inherits from the default configuration are null.
+func flattenCrossTenantAccessPartner(data *CrossTenantAccessPartnerResourceModel, configuration *msgraph.CrossTenantAccessPolicyConfiguration) {
+	data.Id = types.String{Value: configuration.TenantID}
+	data.TenantID = types.String{Value: configuration.TenantID}
+	data.InboundTrust = flattenCrossTenantInboundTrust(configuration.InboundTrust, data.InboundTrust)
+	data.B2BCollaborationInbound = flattenCrossTenantAccessB2BSetting(configuration.B2BCollaborationInbound)
+	data.B2BCollaborationOutbound = flattenCrossTenantAccessB2BSetting(configuration.B2BCollaborationOutbound)
+	data.B2BDirectConnectInbound = flattenCrossTenantAccessB2BSetting(configuration.B2BDirectConnectInbound)
+	data.B2BDirectConnectOutbound = flattenCrossTenantAccessB2BSetting(configuration.B2BDirectConnectOutbound)
+}
+
+func expandCrossTenantInboundTrust(data *CrossTenantInboundTrustModel) *msgraph.CrossTenantAccessPolicyInboundTrust {
+	if data == nil {
+		return nil
+	}
+
+	return &msgraph.CrossTenantAccessPolicyInboundTrust{
+		IsMfaAccepted:                       boolPointer(data.IsMfaAccepted),
+		IsCompliantDeviceAccepted:           boolPointer(data.IsCompliantDeviceAccepted),
+		IsHybridAzureADJoinedDeviceAccepted: boolPointer(data.IsHybridAzureADJoinedDeviceAccepted),
+	}
+}
+
+// flattenCrossTenantInboundTrust keeps trust flags that are false null when
+// they are not configured.
+func flattenCrossTenantInboundTrust(trust *msgraph.CrossTenantAccessPolicyInboundTrust, prior *CrossTenantInboundTrustModel) *CrossTenantInboundTrustModel {
+	if trust == nil {
+		return nil
+	}
+
+	configured := prior != nil
+	if !configured {
+		prior = &CrossTenantInboundTrustModel{
+			IsMfaAccepted:                       types.Bool{Null: true},
+			IsCompliantDeviceAccepted:           types.Bool{Null: true},
+			IsHybridAzureADJoinedDeviceAccepted: types.Bool{Null: true},
+		}
+	}
+
+	data := &CrossTenantInboundTrustModel{
+		IsMfaAccepted:                       optionalBool(trust.IsMfaAccepted, prior.IsMfaAccepted),
+		IsCompliantDeviceAccepted:           optionalBool(trust.IsCompliantDeviceAccepted, prior.IsCompliantDeviceAccepted),
+		IsHybridAzureADJoinedDeviceAccepted: optionalBool(trust.IsHybridAzureADJoinedDeviceAccepted, prior.IsHybridAzureADJoinedDeviceAccepted),
+	}
+	if !configured && data.IsMfaAccepted.IsNull() && data.IsCompliantDeviceAccepted.IsNull() && data.IsHybridAzureADJoinedDeviceAccepted.IsNull() {
+		return nil
+	}
+
+	return data
+}
+
+func expandCrossTenantAccessB2BSetting(data *CrossTenantAccessB2BSettingModel) *msgraph.CrossTenantAccessPolicyB2BSetting {
+	if data == nil {
+		return nil
+	}
+
+	return &msgraph.CrossTenantAccessPolicyB2BSetting{
+		UsersAndGroups: expandCrossTenantAccessTargetConfiguration(data.UsersAndGroups),
+		Applications:   expandCrossTenantAccessTargetConfiguration(data.Applications),
+	}
+}
+
+func flattenCrossTenantAccessB2BSetting(setting *msgraph.CrossTenantAccessPolicyB2BSetting) *CrossTenantAccessB2BSettingModel {
+	if setting == nil || setting.UsersAndGroups == nil || setting.Applications == nil {
+		return nil
+	}
+
+	return &CrossTenantAccessB2BSettingModel{
+		UsersAndGroups: flattenCrossTenantAccessTargetConfiguration(setting.UsersAndGroups),
+		Applications:   flattenCrossTenantAccessTargetConfiguration(setting.Applications),
+	}
+}
+
+func expandCrossTenantAccessTargetConfiguration(data *CrossTenantAccessTargetConfigurationModel) *msgraph.CrossTenantAccessPolicyTargetConfiguration {
+	configuration := &msgraph.CrossTenantAccessPolicyTargetConfiguration{
+		AccessType: data.AccessType.Value,
+		Targets:    make([]msgraph.CrossTenantAccessPolicyTarget, 0),
+	}
+	for i := 0; i < len(data.Targets); i++ {
+		configuration.Targets = append(configuration.Targets, msgraph.CrossTenantAccessPolicyTarget{
+			Target:     data.Targets[i].Target.Value,
+			TargetType: data.Targets[i].TargetType.Value,
+		})
+	}
+
+	return configuration
+}
+
+func flattenCrossTenantAccessTargetConfiguration(configuration *msgraph.CrossTenantAccessPolicyTargetConfiguration) *CrossTenantAccessTargetConfigurationModel {
+	data := &CrossTenantAccessTargetConfigurationModel{
+		AccessType: types.String{Value: configuration.AccessType},
+		Targets:    make([]CrossTenantAccessTargetModel, 0),
+	}
+	for i := 0; i < len(configuration.Targets); i++ {
+		data.Targets = append(data.Targets, CrossTenantAccessTargetModel{
+			Target:     types.String{Value: configuration.Targets[i].Target},
+			TargetType: types.String{Value: configuration.Targets[i].TargetType},
+		})
+	}
+
+	return data
+}
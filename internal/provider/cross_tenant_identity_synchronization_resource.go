@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CrossTenantIdentitySynchronizationResource{}
+var _ resource.ResourceWithImportState = &CrossTenantIdentitySynchronizationResource{}
+
+func NewCrossTenantIdentitySynchronizationResource() resource.Resource {
+	return &CrossTenantIdentitySynchronizationResource{}
+}
+
+// CrossTenantIdentitySynchronizationResource defines the resource implementation.
+type CrossTenantIdentitySynchronizationResource struct {
+	client *msgraph.Client
+}
+
+// CrossTenantIdentitySynchronizationResourceModel describes the resource data model.
+type CrossTenantIdentitySynchronizationResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	TenantID                 types.String `tfsdk:"tenant_id"`
+	DisplayName              types.String `tfsdk:"display_name"`
+	IsUserSyncInboundAllowed types.Bool   `tfsdk:"is_user_sync_inbound_allowed"`
+}
+
+func (r *CrossTenantIdentitySynchronizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cross_tenant_identity_synchronization"
+}
+
+func (r *CrossTenantIdentitySynchronizationResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cross-tenant identity synchronization resource, allows a partner tenant to synchronize users into this tenant. Requires a `msgraph_cross_tenant_access_partner` for the tenant",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Identical to the tenant ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"tenant_id": {
+				MarkdownDescription: "Tenant ID of the partner",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name of the policy",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"is_user_sync_inbound_allowed": {
+				MarkdownDescription: "Whether users of the partner tenant can be synchronized into this tenant",
+				Required:            true,
+				Type:                types.BoolType,
+			},
+		},
+	}, nil
+}
+
+func (r *CrossTenantIdentitySynchronizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CrossTenantIdentitySynchronizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CrossTenantIdentitySynchronizationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.SetCrossTenantIdentitySyncPolicy(expandCrossTenantIdentitySynchronization(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cross-tenant identity synchronization, got error: %s", err))
+		return
+	}
+
+	data.Id = data.TenantID
+	tflog.Trace(ctx, fmt.Sprintf("created cross-tenant identity synchronization %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CrossTenantIdentitySynchronizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CrossTenantIdentitySynchronizationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	policy, err := r.client.GetCrossTenantIdentitySyncPolicy(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("cross-tenant identity synchronization %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cross-tenant identity synchronization, got error: %s", err))
+		return
+	}
+
+	data.TenantID = data.Id
+	data.DisplayName = optionalStringPointer(policy.DisplayName)
+	data.IsUserSyncInboundAllowed = types.Bool{Value: policy.UserSyncInbound != nil && policy.UserSyncInbound.IsSyncAllowed}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CrossTenantIdentitySynchronizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CrossTenantIdentitySynchronizationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.SetCrossTenantIdentitySyncPolicy(expandCrossTenantIdentitySynchronization(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cross-tenant identity synchronization, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CrossTenantIdentitySynchronizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CrossTenantIdentitySynchronizationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteCrossTenantIdentitySyncPolicy(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cross-tenant identity synchronization, got error: %s", err))
+		return
+	}
+}
+
+func (r *CrossTenantIdentitySynchronizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandCrossTenantIdentitySynchronization(data *CrossTenantIdentitySynchronizationResourceModel) msgraph.CrossTenantIdentitySyncPolicyPartner {
+	return msgraph.CrossTenantIdentitySyncPolicyPartner{
+		TenantID:    data.TenantID.Value,
+		DisplayName: stringPointer(data.DisplayName),
+		UserSyncInbound: &msgraph.CrossTenantUserSyncInbound{
+			IsSyncAllowed: data.IsUserSyncInboundAllowed.Value,
+		},
+	}
+}
@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CustomSecurityAttributeAssignmentResource{}
+var _ resource.ResourceWithImportState = &CustomSecurityAttributeAssignmentResource{}
+
+// customSecurityAttributeCollections maps the object types to the graph api
+// collections holding them.
+var customSecurityAttributeCollections = map[string]string{
+	"user":             "users",
+	"servicePrincipal": "servicePrincipals",
+}
+
+func NewCustomSecurityAttributeAssignmentResource() resource.Resource {
+	return &CustomSecurityAttributeAssignmentResource{}
+}
+
+// CustomSecurityAttributeAssignmentResource defines the resource implementation.
+type CustomSecurityAttributeAssignmentResource struct {
+	client *msgraph.Client
+}
+
+// CustomSecurityAttributeAssignmentResourceModel describes the resource data model.
+type CustomSecurityAttributeAssignmentResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	ObjectType    types.String `tfsdk:"object_type"`
+	ObjectID      types.String `tfsdk:"object_id"`
+	AttributeSet  types.String `tfsdk:"attribute_set"`
+	AttributeName types.String `tfsdk:"attribute_name"`
+	Values        types.Set    `tfsdk:"values"`
+}
+
+func (r *CustomSecurityAttributeAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_security_attribute_assignment"
+}
+
+func (r *CustomSecurityAttributeAssignmentResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom security attribute assignment resource, sets the value of a custom security attribute on a user or service principal",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier in the form `objectType/objectId/attributeSet/attributeName`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"object_type": {
+				MarkdownDescription: "`user` or `servicePrincipal`",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("user", "servicePrincipal"),
+				},
+				Type: types.StringType,
+			},
+			"object_id": {
+				MarkdownDescription: "Object ID of the user or service principal",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"attribute_set": {
+				MarkdownDescription: "Name of the attribute set",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"attribute_name": {
+				MarkdownDescription: "Name of the attribute",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"values": {
+				MarkdownDescription: "Values of the attribute, exactly one unless the attribute is a collection. At least one value is required. `Integer` and `Boolean` values are converted from their canonical string form, e.g. `\"42\"` or `\"true\"`",
+				Required:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+		},
+	}, nil
+}
+
+func (r *CustomSecurityAttributeAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomSecurityAttributeAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomSecurityAttributeAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	resp.Diagnostics.Append(r.assign(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf("%s/%s/%s/%s", data.ObjectType.Value, data.ObjectID.Value, data.AttributeSet.Value, data.AttributeName.Value)}
+	tflog.Trace(ctx, fmt.Sprintf("assigned custom security attribute %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomSecurityAttributeAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomSecurityAttributeAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	attributes, err := r.client.GetCustomSecurityAttributes(customSecurityAttributeCollections[data.ObjectType.Value], data.ObjectID.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom security attributes, got error: %s", err))
+		return
+	}
+
+	var values []string
+	if err == nil {
+		values = customSecurityAttributeStrings(attributes.CustomSecurityAttributes[data.AttributeSet.Value][data.AttributeName.Value])
+	}
+	if len(values) == 0 {
+		tflog.Trace(ctx, fmt.Sprintf("custom security attribute %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Values = stringsToSet(values)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomSecurityAttributeAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomSecurityAttributeAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	resp.Diagnostics.Append(r.assign(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomSecurityAttributeAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomSecurityAttributeAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	definition, err := r.client.GetCustomSecurityAttributeDefinition(fmt.Sprintf("%s_%s", data.AttributeSet.Value, data.AttributeName.Value))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom security attribute definition, got error: %s", err))
+		return
+	}
+
+	// Single values are cleared with null, collections with an empty
+	// collection of the attribute type.
+	values := map[string]interface{}{
+		data.AttributeName.Value: nil,
+	}
+	if definition.IsCollection {
+		values[data.AttributeName.Value+"@odata.type"] = customSecurityAttributeODataType(definition)
+		values[data.AttributeName.Value] = []interface{}{}
+	}
+
+	err = r.client.UpdateCustomSecurityAttributes(customSecurityAttributeCollections[data.ObjectType.Value], data.ObjectID.Value, data.AttributeSet.Value, values)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove custom security attribute, got error: %s", err))
+		return
+	}
+}
+
+func (r *CustomSecurityAttributeAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseCompositeID(req.ID, 4)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected objectType/objectId/attributeSet/attributeName: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_set"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute_name"), parts[3])...)
+}
+
+// assign sets the attribute values, they are converted to the type of the
+// attribute definition.
+func (r *CustomSecurityAttributeAssignmentResource) assign(ctx context.Context, data *CustomSecurityAttributeAssignmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	values, d := setToStrings(ctx, data.Values)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	definition, err := r.client.GetCustomSecurityAttributeDefinition(fmt.Sprintf("%s_%s", data.AttributeSet.Value, data.AttributeName.Value))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read custom security attribute definition, got error: %s", err))
+		return diags
+	}
+
+	if len(values) == 0 {
+		diags.AddAttributeError(path.Root("values"), "Invalid Attribute Value", fmt.Sprintf("At least one value of attribute %s must be set.", definition.ID))
+		return diags
+	}
+	if !definition.IsCollection && len(values) != 1 {
+		diags.AddAttributeError(path.Root("values"), "Invalid Attribute Value", fmt.Sprintf("Attribute %s is not a collection, exactly one value must be set.", definition.ID))
+		return diags
+	}
+
+	converted := make([]interface{}, 0)
+	for i := 0; i < len(values); i++ {
+		var value interface{} = values[i]
+		canonical := values[i]
+		switch definition.Type {
+		case "Integer":
+			parsed, parseErr := strconv.ParseInt(values[i], 10, 32)
+			if parseErr != nil {
+				diags.AddAttributeError(path.Root("values"), "Invalid Attribute Value", fmt.Sprintf("%q is not a valid %s value: %s", values[i], definition.Type, parseErr))
+				return diags
+			}
+			value, canonical = parsed, strconv.FormatInt(parsed, 10)
+		case "Boolean":
+			parsed, parseErr := strconv.ParseBool(values[i])
+			if parseErr != nil {
+				diags.AddAttributeError(path.Root("values"), "Invalid Attribute Value", fmt.Sprintf("%q is not a valid %s value: %s", values[i], definition.Type, parseErr))
+				return diags
+			}
+			value, canonical = parsed, strconv.FormatBool(parsed)
+		}
+		// The graph api returns values in their canonical form, any other
+		// form would show up as a difference on every plan.
+		if canonical != values[i] {
+			diags.AddAttributeError(path.Root("values"), "Invalid Attribute Value", fmt.Sprintf("%q must be written as %q.", values[i], canonical))
+			return diags
+		}
+		converted = append(converted, value)
+	}
+
+	payload := map[string]interface{}{
+		data.AttributeName.Value: converted[0],
+	}
+	if definition.IsCollection {
+		payload[data.AttributeName.Value] = converted
+	}
+	if definition.IsCollection || definition.Type == "Integer" {
+		payload[data.AttributeName.Value+"@odata.type"] = customSecurityAttributeODataType(definition)
+	}
+
+	err = r.client.UpdateCustomSecurityAttributes(customSecurityAttributeCollections[data.ObjectType.Value], data.ObjectID.Value, data.AttributeSet.Value, payload)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to assign custom security attribute, got error: %s", err))
+	}
+
+	return diags
+}
+
+// customSecurityAttributeODataType returns the @odata.type annotation of the
+// values of a definition, integers are sent as Int32.
+func customSecurityAttributeODataType(definition *msgraph.CustomSecurityAttributeDefinition) string {
+	valueType := definition.Type
+	if valueType == "Integer" {
+		valueType = "Int32"
+	}
+
+	if definition.IsCollection {
+		return fmt.Sprintf("#Collection(%s)", valueType)
+	}
+	return "#" + valueType
+}
+
+// customSecurityAttributeStrings converts an attribute value returned by the
+// graph api into its string form.
+func customSecurityAttributeStrings(value interface{}) []string {
+	values := make([]string, 0)
+
+	switch v := value.(type) {
+	case []interface{}:
+		for i := 0; i < len(v); i++ {
+			values = append(values, customSecurityAttributeStrings(v[i])...)
+		}
+	case string:
+		values = append(values, v)
+	case float64:
+		values = append(values, strconv.FormatInt(int64(v), 10))
+	case bool:
+		values = append(values, strconv.FormatBool(v))
+	}
+
+	return values
+}
@@ -0,0 +1,451 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CustomSecurityAttributeDefinitionResource{}
+var _ resource.ResourceWithImportState = &CustomSecurityAttributeDefinitionResource{}
+var _ resource.ResourceWithValidateConfig = &CustomSecurityAttributeDefinitionResource{}
+var _ resource.ResourceWithModifyPlan = &CustomSecurityAttributeDefinitionResource{}
+
+func NewCustomSecurityAttributeDefinitionResource() resource.Resource {
+	return &CustomSecurityAttributeDefinitionResource{}
+}
+
+// CustomSecurityAttributeDefinitionResource defines the resource implementation.
+type CustomSecurityAttributeDefinitionResource struct {
+	client *msgraph.Client
+}
+
+// CustomSecurityAttributeDefinitionResourceModel describes the resource data model.
+type CustomSecurityAttributeDefinitionResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	AttributeSet            types.String `tfsdk:"attribute_set"`
+	Name                    types.String `tfsdk:"name"`
+	Description             types.String `tfsdk:"description"`
+	Type                    types.String `tfsdk:"type"`
+	IsCollection            types.Bool   `tfsdk:"is_collection"`
+	IsSearchable            types.Bool   `tfsdk:"is_searchable"`
+	UsePreDefinedValuesOnly types.Bool   `tfsdk:"use_pre_defined_values_only"`
+	AllowedValues           types.Set    `tfsdk:"allowed_values"`
+	Status                  types.String `tfsdk:"status"`
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_security_attribute_definition"
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom security attribute definition resource, defines an attribute of an attribute set. Definitions and allowed values cannot be deleted, destroying the resource deprecates the definition and removed allowed values are deactivated",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Definition ID in the form `attributeSet_name`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"attribute_set": {
+				MarkdownDescription: "Name of the attribute set",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"name": {
+				MarkdownDescription: "Name of the attribute, unique within the attribute set",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"description": {
+				MarkdownDescription: "Description",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"type": {
+				MarkdownDescription: "`String`, `Integer` or `Boolean`. Cannot be changed",
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("String", "Integer", "Boolean"),
+				},
+				Type: types.StringType,
+			},
+			"is_collection": {
+				MarkdownDescription: "Whether the attribute holds multiple values. Cannot be changed",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"is_searchable": {
+				MarkdownDescription: "Whether the values are indexed for searching. Cannot be changed",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"use_pre_defined_values_only": {
+				MarkdownDescription: "Whether only the allowed values can be assigned",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"allowed_values": {
+				MarkdownDescription: "Predefined values of a `String` attribute, removed values are deactivated",
+				Optional:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"status": {
+				MarkdownDescription: "`Available` or `Deprecated`, defaults to `Available`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(msgraph.CustomSecurityAttributeStatusAvailable, msgraph.CustomSecurityAttributeStatusDeprecated),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CustomSecurityAttributeDefinitionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.AllowedValues.IsNull() && !data.Type.IsUnknown() && data.Type.Value != "String" {
+		resp.Diagnostics.AddAttributeError(path.Root("allowed_values"), "Invalid Attribute Combination", "allowed_values can only be set for String attributes.")
+	}
+	if data.UsePreDefinedValuesOnly.Value && data.AllowedValues.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("use_pre_defined_values_only"), "Missing Attribute", "use_pre_defined_values_only requires allowed_values.")
+	}
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to enforce when the resource is created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CustomSecurityAttributeDefinitionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A replacement cannot succeed, the deprecated definition keeps its
+	// name. Unset flags are false in the graph api.
+	if !plan.Type.IsUnknown() && !plan.Type.Equal(state.Type) {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Attribute Change", fmt.Sprintf("The type of custom security attribute definition %s cannot be changed, use a new name instead.", state.Id.Value))
+	}
+	if !plan.IsCollection.IsUnknown() && plan.IsCollection.Value != state.IsCollection.Value {
+		resp.Diagnostics.AddAttributeError(path.Root("is_collection"), "Invalid Attribute Change", fmt.Sprintf("is_collection of custom security attribute definition %s cannot be changed, use a new name instead.", state.Id.Value))
+	}
+	if !plan.IsSearchable.IsUnknown() && plan.IsSearchable.Value != state.IsSearchable.Value {
+		resp.Diagnostics.AddAttributeError(path.Root("is_searchable"), "Invalid Attribute Change", fmt.Sprintf("is_searchable of custom security attribute definition %s cannot be changed, use a new name instead.", state.Id.Value))
+	}
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CustomSecurityAttributeDefinitionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition := expandCustomSecurityAttributeDefinition(data)
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateCustomSecurityAttributeDefinition(definition)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom security attribute definition, got error: %s", err))
+		return
+	}
+	data.Id = types.String{Value: created.ID}
+	data.Status = types.String{Value: created.Status}
+	tflog.Trace(ctx, fmt.Sprintf("created custom security attribute definition %s", data.Id.Value))
+
+	// Save the definition before syncing the allowed values, definitions
+	// cannot be deleted and an untracked definition blocks its name.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	resp.Diagnostics.Append(r.syncAllowedValues(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomSecurityAttributeDefinitionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	definition, err := r.client.GetCustomSecurityAttributeDefinition(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("custom security attribute definition %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom security attribute definition, got error: %s", err))
+		return
+	}
+
+	values, err := r.client.ListAllowedValues(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read allowed values, got error: %s", err))
+		return
+	}
+
+	flattenCustomSecurityAttributeDefinition(data, definition, values)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CustomSecurityAttributeDefinitionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition := expandCustomSecurityAttributeDefinition(data)
+	definition.ID = data.Id.Value
+
+	// Allowed values are activated before the update, so
+	// use_pre_defined_values_only can be enabled in the same apply.
+	r.client.GraphAccess()
+	resp.Diagnostics.Append(r.syncAllowedValues(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateCustomSecurityAttributeDefinition(definition)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom security attribute definition, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomSecurityAttributeDefinitionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Definitions cannot be deleted, they are deprecated instead and only
+	// dropped from the state.
+	definition := expandCustomSecurityAttributeDefinition(data)
+	definition.ID = data.Id.Value
+	definition.Status = msgraph.CustomSecurityAttributeStatusDeprecated
+
+	r.client.GraphAccess()
+	err := r.client.UpdateCustomSecurityAttributeDefinition(definition)
+	if msgraph.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deprecate custom security attribute definition, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Custom security attribute definition deprecated",
+		fmt.Sprintf("Custom security attribute definition %s was deprecated instead of deleted, definitions cannot be deleted.", data.Id.Value),
+	)
+}
+
+func (r *CustomSecurityAttributeDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// syncAllowedValues adds or reactivates the configured allowed values and
+// deactivates the others, allowed values cannot be deleted.
+func (r *CustomSecurityAttributeDefinitionResource) syncAllowedValues(ctx context.Context, data *CustomSecurityAttributeDefinitionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.AllowedValues.IsUnknown() {
+		return diags
+	}
+
+	planned, d := setToStrings(ctx, data.AllowedValues)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.client.ListAllowedValues(data.Id.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read allowed values, got error: %s", err))
+		return diags
+	}
+
+	existing := make(map[string]bool)
+	for i := 0; i < len(current); i++ {
+		value := current[i]
+		existing[value.ID] = true
+
+		wanted := containsString(planned, value.ID)
+		if wanted == value.IsActive {
+			continue
+		}
+
+		err := r.client.SetAllowedValueActive(data.Id.Value, value.ID, wanted)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update allowed value %s, got error: %s", value.ID, err))
+			return diags
+		}
+	}
+
+	for i := 0; i < len(planned); i++ {
+		if existing[planned[i]] {
+			continue
+		}
+
+		err := r.client.AddAllowedValue(data.Id.Value, planned[i])
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add allowed value %s, got error: %s", planned[i], err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// read refreshes the definition and its allowed values.
+func (r *CustomSecurityAttributeDefinitionResource) read(data *CustomSecurityAttributeDefinitionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	definition, err := r.client.GetCustomSecurityAttributeDefinition(data.Id.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read custom security attribute definition, got error: %s", err))
+		return diags
+	}
+
+	values, err := r.client.ListAllowedValues(data.Id.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read allowed values, got error: %s", err))
+		return diags
+	}
+
+	flattenCustomSecurityAttributeDefinition(data, definition, values)
+
+	return diags
+}
+
+// flattenCustomSecurityAttributeDefinition updates the model from the graph
+// api, only the active allowed values are tracked.
+func flattenCustomSecurityAttributeDefinition(data *CustomSecurityAttributeDefinitionResourceModel, definition *msgraph.CustomSecurityAttributeDefinition, values []msgraph.AllowedValue) {
+	active := make([]string, 0)
+	for i := 0; i < len(values); i++ {
+		if values[i].IsActive {
+			active = append(active, values[i].ID)
+		}
+	}
+
+	data.Id = types.String{Value: definition.ID}
+	data.AttributeSet = types.String{Value: definition.AttributeSet}
+	data.Name = types.String{Value: definition.Name}
+	data.Description = optionalString(definition.Description)
+	data.Type = types.String{Value: definition.Type}
+	data.IsCollection = optionalBool(&definition.IsCollection, data.IsCollection)
+	data.IsSearchable = optionalBool(&definition.IsSearchable, data.IsSearchable)
+	data.UsePreDefinedValuesOnly = optionalBool(&definition.UsePreDefinedValuesOnly, data.UsePreDefinedValuesOnly)
+	data.AllowedValues = optionalStringsToSet(active)
+	data.Status = types.String{Value: definition.Status}
+}
+
+func expandCustomSecurityAttributeDefinition(data *CustomSecurityAttributeDefinitionResourceModel) msgraph.CustomSecurityAttributeDefinition {
+	definition := msgraph.CustomSecurityAttributeDefinition{
+		AttributeSet:            data.AttributeSet.Value,
+		Name:                    data.Name.Value,
+		Description:             data.Description.Value,
+		Type:                    data.Type.Value,
+		Status:                  msgraph.CustomSecurityAttributeStatusAvailable,
+		IsCollection:            data.IsCollection.Value,
+		IsSearchable:            data.IsSearchable.Value,
+		UsePreDefinedValuesOnly: data.UsePreDefinedValuesOnly.Value,
+	}
+	if !data.Status.IsNull() && !data.Status.IsUnknown() {
+		definition.Status = data.Status.Value
+	}
+
+	return definition
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &DirectoryRoleAssignmentResource{}
+var _ resource.ResourceWithImportState = &DirectoryRoleAssignmentResource{}
+var _ resource.ResourceWithValidateConfig = &DirectoryRoleAssignmentResource{}
+
+func NewDirectoryRoleAssignmentResource() resource.Resource {
+	return &DirectoryRoleAssignmentResource{}
+}
+
+// DirectoryRoleAssignmentResource defines the resource implementation.
+type DirectoryRoleAssignmentResource struct {
+	client *msgraph.Client
+}
+
+// DirectoryRoleAssignmentResourceModel describes the resource data model.
+type DirectoryRoleAssignmentResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	PrincipalObjectID types.String `tfsdk:"principal_object_id"`
+	RoleDefinitionID  types.String `tfsdk:"role_definition_id"`
+	RoleName          types.String `tfsdk:"role_name"`
+	DirectoryScopeID  types.String `tfsdk:"directory_scope_id"`
+}
+
+func (r *DirectoryRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_role_assignment"
+}
+
+func (r *DirectoryRoleAssignmentResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Directory role assignment resource, assigns a built-in or custom directory role to a principal for the tenant, an administrative unit or a single object",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Role assignment ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"principal_object_id": {
+				MarkdownDescription: "Object ID of the user, role assignable group or service principal the role is assigned to",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"role_definition_id": {
+				MarkdownDescription: "Role definition ID, the template ID for built-in roles. Conflicts with `role_name`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"role_name": {
+				MarkdownDescription: "Role display name, e.g. `Application Administrator`, resolved to the role definition ID. Conflicts with `role_definition_id`",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					// Imported assignments have no name in state yet, setting
+					// it afterwards must not replace the assignment.
+					resource.RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, _ path.Path) (bool, diag.Diagnostics) {
+						return !state.IsNull(), nil
+					}, "Replaces the assignment when the role name changes.", "Replaces the assignment when the role name changes."),
+				},
+				Type: types.StringType,
+			},
+			"directory_scope_id": {
+				MarkdownDescription: "Scope of the assignment, `/` for the tenant, `/administrativeUnits/{id}` for an administrative unit or `/{objectId}` for an application. Defaults to `/`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *DirectoryRoleAssignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DirectoryRoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RoleDefinitionID.IsNull() && !data.RoleName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("role_name"), "Conflicting Attributes", "Only one of role_definition_id or role_name can be set.")
+	}
+	if data.RoleDefinitionID.IsNull() && data.RoleName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("role_definition_id"), "Missing Attribute", "One of role_definition_id or role_name must be set.")
+	}
+}
+
+func (r *DirectoryRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DirectoryRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DirectoryRoleAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+
+	roleDefinitionID := data.RoleDefinitionID.Value
+	if !data.RoleName.IsNull() {
+		definition, err := r.client.GetRoleDefinitionByDisplayName(data.RoleName.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve role definition, got error: %s", err))
+			return
+		}
+		roleDefinitionID = definition.ID
+	}
+
+	directoryScopeID := msgraph.DirectoryScopeTenant
+	if !data.DirectoryScopeID.IsUnknown() && !data.DirectoryScopeID.IsNull() {
+		directoryScopeID = data.DirectoryScopeID.Value
+	}
+
+	created, err := r.client.CreateRoleAssignment(msgraph.UnifiedRoleAssignment{
+		PrincipalID:      data.PrincipalObjectID.Value,
+		RoleDefinitionID: roleDefinitionID,
+		DirectoryScopeID: directoryScopeID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create directory role assignment, got error: %s", err))
+		return
+	}
+
+	flattenDirectoryRoleAssignment(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created directory role assignment %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DirectoryRoleAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	assignment, err := r.client.GetRoleAssignment(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("directory role assignment %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read directory role assignment, got error: %s", err))
+		return
+	}
+
+	flattenDirectoryRoleAssignment(data, assignment)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DirectoryRoleAssignmentResourceModel
+
+	// All attributes require replacement, the plan is saved as is.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DirectoryRoleAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteRoleAssignment(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete directory role assignment, got error: %s", err))
+		return
+	}
+}
+
+func (r *DirectoryRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func flattenDirectoryRoleAssignment(data *DirectoryRoleAssignmentResourceModel, assignment *msgraph.UnifiedRoleAssignment) {
+	data.Id = types.String{Value: assignment.ID}
+	data.PrincipalObjectID = types.String{Value: assignment.PrincipalID}
+	data.RoleDefinitionID = types.String{Value: assignment.RoleDefinitionID}
+	data.DirectoryScopeID = types.String{Value: assignment.DirectoryScopeID}
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &DirectoryRoleDefinitionResource{}
+var _ resource.ResourceWithImportState = &DirectoryRoleDefinitionResource{}
+
+// resourceActionPattern matches directory resource actions of the form
+// microsoft.directory/{resource}/{action} or
+// microsoft.directory/{resource}/{property}/{action}.
+var resourceActionPattern = regexp.MustCompile(`^microsoft\.directory(/[A-Za-z0-9][A-Za-z0-9.\-]*){2,3}$`)
+
+func NewDirectoryRoleDefinitionResource() resource.Resource {
+	return &DirectoryRoleDefinitionResource{}
+}
+
+// DirectoryRoleDefinitionResource defines the resource implementation.
+type DirectoryRoleDefinitionResource struct {
+	client *msgraph.Client
+}
+
+// DirectoryRoleDefinitionResourceModel describes the resource data model.
+type DirectoryRoleDefinitionResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	DisplayName            types.String `tfsdk:"display_name"`
+	Description            types.String `tfsdk:"description"`
+	AllowedResourceActions types.Set    `tfsdk:"allowed_resource_actions"`
+	IsEnabled              types.Bool   `tfsdk:"is_enabled"`
+	TemplateID             types.String `tfsdk:"template_id"`
+	Version                types.String `tfsdk:"version"`
+}
+
+func (r *DirectoryRoleDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_role_definition"
+}
+
+func (r *DirectoryRoleDefinitionResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Directory role definition resource, creates a custom directory role",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Role definition ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"description": {
+				MarkdownDescription: "Description",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"allowed_resource_actions": {
+				MarkdownDescription: "Resource actions granted by the role, e.g. `microsoft.directory/applications/credentials/update`",
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					stringSetMatches(resourceActionPattern, "value must be a resource action of the form microsoft.directory/{resource}/{property}/{action}"),
+				},
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"is_enabled": {
+				MarkdownDescription: "Whether the role is enabled, defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.BoolType,
+			},
+			"template_id": {
+				MarkdownDescription: "Template ID, generated when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"version": {
+				MarkdownDescription: "Version of the role definition, free text maintained by the author",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *DirectoryRoleDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DirectoryRoleDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DirectoryRoleDefinitionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IsEnabled.IsUnknown() {
+		data.IsEnabled = types.Bool{Value: true}
+	}
+
+	definition, diags := expandDirectoryRoleDefinition(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.TemplateID.IsUnknown() {
+		definition.TemplateID = data.TemplateID.Value
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateRoleDefinition(definition)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create directory role definition, got error: %s", err))
+		return
+	}
+
+	flattenDirectoryRoleDefinition(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created directory role definition %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryRoleDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DirectoryRoleDefinitionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	definition, err := r.client.GetRoleDefinition(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("directory role definition %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read directory role definition, got error: %s", err))
+		return
+	}
+
+	flattenDirectoryRoleDefinition(data, definition)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryRoleDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DirectoryRoleDefinitionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, diags := expandDirectoryRoleDefinition(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	definition.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateRoleDefinition(definition)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update directory role definition, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetRoleDefinition(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read directory role definition, got error: %s", err))
+		return
+	}
+
+	flattenDirectoryRoleDefinition(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryRoleDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DirectoryRoleDefinitionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteRoleDefinition(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete directory role definition, got error: %s", err))
+		return
+	}
+}
+
+func (r *DirectoryRoleDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandDirectoryRoleDefinition(ctx context.Context, data *DirectoryRoleDefinitionResourceModel) (msgraph.UnifiedRoleDefinition, diag.Diagnostics) {
+	actions, diags := setToStrings(ctx, data.AllowedResourceActions)
+
+	return msgraph.UnifiedRoleDefinition{
+		DisplayName: data.DisplayName.Value,
+		Description: stringPointer(data.Description),
+		IsEnabled:   data.IsEnabled.Value,
+		Version:     stringPointer(data.Version),
+		RolePermissions: []msgraph.UnifiedRolePermission{
+			{AllowedResourceActions: actions},
+		},
+	}, diags
+}
+
+func flattenDirectoryRoleDefinition(data *DirectoryRoleDefinitionResourceModel, definition *msgraph.UnifiedRoleDefinition) {
+	actions := make([]string, 0)
+	for i := 0; i < len(definition.RolePermissions); i++ {
+		actions = append(actions, definition.RolePermissions[i].AllowedResourceActions...)
+	}
+
+	data.Id = types.String{Value: definition.ID}
+	data.DisplayName = types.String{Value: definition.DisplayName}
+	data.Description = optionalStringPointer(definition.Description)
+	data.AllowedResourceActions = stringsToSet(actions)
+	data.IsEnabled = types.Bool{Value: definition.IsEnabled}
+	data.TemplateID = types.String{Value: definition.TemplateID}
+	data.Version = optionalStringPointer(definition.Version)
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &DirectoryRoleDefinitionsDataSource{}
+
+func NewDirectoryRoleDefinitionsDataSource() datasource.DataSource {
+	return &DirectoryRoleDefinitionsDataSource{}
+}
+
+// DirectoryRoleDefinitionsDataSource defines the data source implementation.
+type DirectoryRoleDefinitionsDataSource struct {
+	client *msgraph.Client
+}
+
+// DirectoryRoleDefinitionsDataSourceModel describes the data source data model.
+type DirectoryRoleDefinitionsDataSourceModel struct {
+	Id                types.String                   `tfsdk:"id"`
+	DisplayNames      types.List                     `tfsdk:"display_names"`
+	RoleDefinitionIDs types.Map                      `tfsdk:"role_definition_ids"`
+	RoleDefinitions   []DirectoryRoleDefinitionModel `tfsdk:"role_definitions"`
+}
+
+// DirectoryRoleDefinitionModel describes a role definition returned by the
+// data source.
+type DirectoryRoleDefinitionModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	TemplateID  types.String `tfsdk:"template_id"`
+	IsBuiltIn   types.Bool   `tfsdk:"is_built_in"`
+	IsEnabled   types.Bool   `tfsdk:"is_enabled"`
+}
+
+func (d *DirectoryRoleDefinitionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_role_definitions"
+}
+
+func (d *DirectoryRoleDefinitionsDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Directory role definitions data source, lists the built-in and custom directory roles and resolves display names to role definition IDs",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "identifier",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"display_names": {
+				MarkdownDescription: "Display names of the roles to return, e.g. `Cloud Application Administrator`. Every name must exist, all roles are returned when not set",
+				Optional:            true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"role_definition_ids": {
+				MarkdownDescription: "Role definition IDs of the returned roles keyed by display name",
+				Computed:            true,
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+			},
+			"role_definitions": {
+				MarkdownDescription: "Returned role definitions",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "Role definition ID",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"display_name": {
+						MarkdownDescription: "Display name",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"description": {
+						MarkdownDescription: "Description",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"template_id": {
+						MarkdownDescription: "Template ID, identical in every tenant for built-in roles",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"is_built_in": {
+						MarkdownDescription: "Whether the role is built-in",
+						Computed:            true,
+						Type:                types.BoolType,
+					},
+					"is_enabled": {
+						MarkdownDescription: "Whether the role is enabled",
+						Computed:            true,
+						Type:                types.BoolType,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (d *DirectoryRoleDefinitionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DirectoryRoleDefinitionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DirectoryRoleDefinitionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	displayNames, diags := listToStrings(ctx, data.DisplayNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client.GraphAccess()
+	definitions, err := d.client.ListRoleDefinitions()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list directory role definitions, got error: %s", err))
+		return
+	}
+
+	found := make(map[string]bool)
+	ids := make(map[string]attr.Value)
+	data.RoleDefinitions = make([]DirectoryRoleDefinitionModel, 0)
+	for i := 0; i < len(definitions); i++ {
+		definition := definitions[i]
+		if len(displayNames) > 0 && !containsString(displayNames, definition.DisplayName) {
+			continue
+		}
+
+		found[definition.DisplayName] = true
+		ids[definition.DisplayName] = types.String{Value: definition.ID}
+		data.RoleDefinitions = append(data.RoleDefinitions, DirectoryRoleDefinitionModel{
+			Id:          types.String{Value: definition.ID},
+			DisplayName: types.String{Value: definition.DisplayName},
+			Description: optionalStringPointer(definition.Description),
+			TemplateID:  optionalString(definition.TemplateID),
+			IsBuiltIn:   types.Bool{Value: definition.IsBuiltIn},
+			IsEnabled:   types.Bool{Value: definition.IsEnabled},
+		})
+	}
+
+	for i := 0; i < len(displayNames); i++ {
+		if !found[displayNames[i]] {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("No directory role definition named %q found", displayNames[i]))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: "directory_role_definitions"}
+	data.RoleDefinitionIDs = types.Map{
+		Elems:    ids,
+		ElemType: types.StringType,
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("listed %d directory role definitions", len(data.RoleDefinitions)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
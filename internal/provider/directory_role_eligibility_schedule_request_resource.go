@@ -0,0 +1,472 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &DirectoryRoleEligibilityScheduleRequestResource{}
+var _ resource.ResourceWithImportState = &DirectoryRoleEligibilityScheduleRequestResource{}
+var _ resource.ResourceWithValidateConfig = &DirectoryRoleEligibilityScheduleRequestResource{}
+
+func NewDirectoryRoleEligibilityScheduleRequestResource() resource.Resource {
+	return &DirectoryRoleEligibilityScheduleRequestResource{}
+}
+
+// The schedule of a new request is looked up for up to
+// roleEligibilityScheduleAttempts times roleEligibilityScheduleInterval.
+const (
+	roleEligibilityScheduleAttempts = 6
+	roleEligibilityScheduleInterval = 5 * time.Second
+)
+
+// DirectoryRoleEligibilityScheduleRequestResource defines the resource implementation.
+type DirectoryRoleEligibilityScheduleRequestResource struct {
+	client *msgraph.Client
+}
+
+// DirectoryRoleEligibilityScheduleRequestResourceModel describes the resource data model.
+type DirectoryRoleEligibilityScheduleRequestResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	PrincipalObjectID  types.String `tfsdk:"principal_object_id"`
+	RoleDefinitionID   types.String `tfsdk:"role_definition_id"`
+	DirectoryScopeID   types.String `tfsdk:"directory_scope_id"`
+	Justification      types.String `tfsdk:"justification"`
+	StartDateTime      types.String `tfsdk:"start_date_time"`
+	Duration           types.String `tfsdk:"duration"`
+	EndDateTime        types.String `tfsdk:"end_date_time"`
+	ScheduleID         types.String `tfsdk:"schedule_id"`
+	Status             types.String `tfsdk:"status"`
+	ExpirationDateTime types.String `tfsdk:"expiration_date_time"`
+}
+
+func (r *DirectoryRoleEligibilityScheduleRequestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_role_eligibility_schedule_request"
+}
+
+func (r *DirectoryRoleEligibilityScheduleRequestResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Directory role eligibility schedule request resource, makes a principal eligible for a directory role through Privileged Identity Management. Destroying the resource removes the eligibility",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Schedule request ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"principal_object_id": {
+				MarkdownDescription: "Object ID of the user or role assignable group made eligible",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"role_definition_id": {
+				MarkdownDescription: "Role definition ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"directory_scope_id": {
+				MarkdownDescription: "Scope of the eligibility, defaults to `/` for the tenant",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"justification": {
+				MarkdownDescription: "Justification recorded with the request",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"start_date_time": {
+				MarkdownDescription: "Start of the eligibility in RFC 3339 format, defaults to the time of the request",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"duration": {
+				MarkdownDescription: "ISO 8601 duration of the eligibility, e.g. `P90D`. Conflicts with `end_date_time`, the eligibility does not expire when neither is set",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"end_date_time": {
+				MarkdownDescription: "End of the eligibility in RFC 3339 format. Conflicts with `duration`",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"schedule_id": {
+				Computed:            true,
+				MarkdownDescription: "ID of the resulting eligibility schedule",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"status": {
+				Computed:            true,
+				MarkdownDescription: "Status of the eligibility schedule, e.g. `Provisioned`, or of the request while the schedule is pending",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"expiration_date_time": {
+				Computed:            true,
+				MarkdownDescription: "End of the eligibility as reported by the eligibility schedule, null when it does not expire",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *DirectoryRoleEligibilityScheduleRequestResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DirectoryRoleEligibilityScheduleRequestResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Duration.IsNull() && !data.EndDateTime.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("end_date_time"), "Conflicting Attributes", "Only one of duration or end_date_time can be set.")
+	}
+
+	for name, value := range map[string]types.String{"start_date_time": data.StartDateTime, "end_date_time": data.EndDateTime} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value.Value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Value", fmt.Sprintf("%q is not a valid RFC 3339 date time: %s", value.Value, err))
+		}
+	}
+}
+
+func (r *DirectoryRoleEligibilityScheduleRequestResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DirectoryRoleEligibilityScheduleRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DirectoryRoleEligibilityScheduleRequestResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DirectoryScopeID.IsUnknown() {
+		data.DirectoryScopeID = types.String{Value: msgraph.DirectoryScopeTenant}
+	}
+
+	expiration := &msgraph.ExpirationPattern{Type: msgraph.ExpirationTypeNoExpiration}
+	switch {
+	case !data.Duration.IsNull():
+		expiration = &msgraph.ExpirationPattern{Type: msgraph.ExpirationTypeAfterDuration, Duration: &data.Duration.Value}
+	case !data.EndDateTime.IsNull():
+		expiration = &msgraph.ExpirationPattern{Type: msgraph.ExpirationTypeAfterDateTime, EndDateTime: &data.EndDateTime.Value}
+	}
+
+	request := msgraph.UnifiedRoleEligibilityScheduleRequest{
+		Action:           msgraph.ScheduleRequestActionAdminAssign,
+		PrincipalID:      data.PrincipalObjectID.Value,
+		RoleDefinitionID: data.RoleDefinitionID.Value,
+		DirectoryScopeID: data.DirectoryScopeID.Value,
+		Justification:    stringPointer(data.Justification),
+		ScheduleInfo: &msgraph.RequestSchedule{
+			StartDateTime: stringPointer(data.StartDateTime),
+			Expiration:    expiration,
+		},
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateRoleEligibilityScheduleRequest(request)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create role eligibility schedule request, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, fmt.Sprintf("created role eligibility schedule request %s", created.ID))
+
+	data.Id = types.String{Value: created.ID}
+	data.Status = types.String{Value: created.Status}
+	if data.StartDateTime.IsUnknown() {
+		data.StartDateTime = types.String{Null: true}
+		if created.ScheduleInfo != nil {
+			data.StartDateTime = optionalStringPointer(created.ScheduleInfo.StartDateTime)
+		}
+	}
+
+	data.ScheduleID = types.String{Null: true}
+	data.ExpirationDateTime = types.String{Null: true}
+
+	// Save the request before looking up the schedule, the eligibility
+	// exists once the request is accepted.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.waitForRoleEligibilitySchedule(ctx, data)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("role eligibility schedule for request %s not found yet, leaving it to read", data.Id.Value))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role eligibility schedule, got error: %s", err))
+		return
+	}
+
+	flattenRoleEligibilitySchedule(data, schedule)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryRoleEligibilityScheduleRequestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DirectoryRoleEligibilityScheduleRequestResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+
+	// Imported requests only have an ID, the request itself tells which
+	// schedule it resulted in.
+	if data.PrincipalObjectID.IsNull() {
+		request, err := r.client.GetRoleEligibilityScheduleRequest(data.Id.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role eligibility schedule request, got error: %s", err))
+			return
+		}
+		flattenRoleEligibilityScheduleRequest(data, request)
+	}
+
+	schedule, err := r.client.FindRoleEligibilitySchedule(data.PrincipalObjectID.Value, data.RoleDefinitionID.Value, data.DirectoryScopeID.Value)
+	if msgraph.IsNotFound(err) && data.ScheduleID.IsNull() {
+		// The schedule of a new request can still be pending, the request
+		// is only dropped once it did not succeed.
+		request, err := r.client.GetRoleEligibilityScheduleRequest(data.Id.Value)
+		if err != nil && !msgraph.IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role eligibility schedule request, got error: %s", err))
+			return
+		}
+		if err == nil && !roleEligibilityScheduleRequestFailed(request.Status) {
+			tflog.Trace(ctx, fmt.Sprintf("role eligibility schedule for request %s is pending", data.Id.Value))
+			data.Status = types.String{Value: request.Status}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("role eligibility schedule for request %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role eligibility schedule, got error: %s", err))
+		return
+	}
+
+	flattenRoleEligibilitySchedule(data, schedule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryRoleEligibilityScheduleRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DirectoryRoleEligibilityScheduleRequestResourceModel
+
+	// All attributes require replacement, the plan is saved as is.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryRoleEligibilityScheduleRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DirectoryRoleEligibilityScheduleRequestResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+
+	// Removing an eligibility that expired or was removed elsewhere fails,
+	// so the schedule is checked first.
+	_, err := r.client.FindRoleEligibilitySchedule(data.PrincipalObjectID.Value, data.RoleDefinitionID.Value, data.DirectoryScopeID.Value)
+	if msgraph.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role eligibility schedule, got error: %s", err))
+		return
+	}
+
+	_, err = r.client.CreateRoleEligibilityScheduleRequest(msgraph.UnifiedRoleEligibilityScheduleRequest{
+		Action:           msgraph.ScheduleRequestActionAdminRemove,
+		PrincipalID:      data.PrincipalObjectID.Value,
+		RoleDefinitionID: data.RoleDefinitionID.Value,
+		DirectoryScopeID: data.DirectoryScopeID.Value,
+	})
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove role eligibility, got error: %s", err))
+		return
+	}
+}
+
+func (r *DirectoryRoleEligibilityScheduleRequestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForRoleEligibilitySchedule looks up the schedule of a new request,
+// the schedule shows up with a replication delay.
+func (r *DirectoryRoleEligibilityScheduleRequestResource) waitForRoleEligibilitySchedule(ctx context.Context, data *DirectoryRoleEligibilityScheduleRequestResourceModel) (*msgraph.UnifiedRoleEligibilitySchedule, error) {
+	for attempt := 1; ; attempt++ {
+		schedule, err := r.client.FindRoleEligibilitySchedule(data.PrincipalObjectID.Value, data.RoleDefinitionID.Value, data.DirectoryScopeID.Value)
+		if !msgraph.IsNotFound(err) || attempt == roleEligibilityScheduleAttempts {
+			return schedule, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(roleEligibilityScheduleInterval):
+		}
+	}
+}
+
+// roleEligibilityScheduleRequestFailed reports whether a request ended
+// without creating a schedule.
+func roleEligibilityScheduleRequestFailed(status string) bool {
+	switch status {
+	case "Failed", "Denied", "Canceled", "Revoked":
+		return true
+	}
+
+	return false
+}
+
+func flattenRoleEligibilityScheduleRequest(data *DirectoryRoleEligibilityScheduleRequestResourceModel, request *msgraph.UnifiedRoleEligibilityScheduleRequest) {
+	data.PrincipalObjectID = types.String{Value: request.PrincipalID}
+	data.RoleDefinitionID = types.String{Value: request.RoleDefinitionID}
+	data.DirectoryScopeID = types.String{Value: request.DirectoryScopeID}
+	data.Justification = optionalStringPointer(request.Justification)
+	data.StartDateTime = types.String{Null: true}
+	data.Duration = types.String{Null: true}
+	data.EndDateTime = types.String{Null: true}
+
+	if request.ScheduleInfo == nil {
+		return
+	}
+	data.StartDateTime = optionalStringPointer(request.ScheduleInfo.StartDateTime)
+	if request.ScheduleInfo.Expiration != nil {
+		data.Duration = optionalStringPointer(request.ScheduleInfo.Expiration.Duration)
+		data.EndDateTime = optionalStringPointer(request.ScheduleInfo.Expiration.EndDateTime)
+	}
+}
+
+// flattenRoleEligibilitySchedule updates the model from the eligibility
+// schedule. A configured start or end that no longer matches the schedule
+// is replaced by the schedule value, so the drift shows up in the plan.
+func flattenRoleEligibilitySchedule(data *DirectoryRoleEligibilityScheduleRequestResourceModel, schedule *msgraph.UnifiedRoleEligibilitySchedule) {
+	data.ScheduleID = types.String{Value: schedule.ID}
+	data.Status = types.String{Value: schedule.Status}
+	data.ExpirationDateTime = types.String{Null: true}
+
+	if schedule.ScheduleInfo == nil {
+		return
+	}
+
+	if schedule.ScheduleInfo.StartDateTime != nil && !sameDateTime(data.StartDateTime.Value, *schedule.ScheduleInfo.StartDateTime) {
+		data.StartDateTime = types.String{Value: *schedule.ScheduleInfo.StartDateTime}
+	}
+
+	if schedule.ScheduleInfo.Expiration == nil {
+		return
+	}
+	data.ExpirationDateTime = optionalStringPointer(schedule.ScheduleInfo.Expiration.EndDateTime)
+
+	if !data.EndDateTime.IsNull() && !sameDateTime(data.EndDateTime.Value, data.ExpirationDateTime.Value) {
+		data.EndDateTime = data.ExpirationDateTime
+	}
+}
+
+// sameDateTime reports whether two RFC 3339 date times are the same instant,
+// the graph api does not return them in the format they were sent in.
+func sameDateTime(a string, b string) bool {
+	timeA, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return a == b
+	}
+	timeB, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return a == b
+	}
+
+	return timeA.Equal(timeB)
+}
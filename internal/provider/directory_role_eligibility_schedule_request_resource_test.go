@@ -0,0 +1,29 @@
+package provider
+
+import "testing"
+
+func TestSameDateTime(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "identical", a: "2024-01-02T03:04:05Z", b: "2024-01-02T03:04:05Z", want: true},
+		{name: "fractional seconds", a: "2024-01-02T03:04:05Z", b: "2024-01-02T03:04:05.000Z", want: true},
+		{name: "time zone offset", a: "2024-01-02T03:04:05Z", b: "2024-01-02T04:04:05+01:00", want: true},
+		{name: "different time", a: "2024-01-02T03:04:05Z", b: "2024-01-02T03:04:06Z", want: false},
+		{name: "both invalid and equal", a: "tomorrow", b: "tomorrow", want: true},
+		{name: "both invalid and different", a: "tomorrow", b: "today", want: false},
+		{name: "one invalid", a: "2024-01-02T03:04:05Z", b: "2024-01-02", want: false},
+		{name: "both empty", a: "", b: "", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameDateTime(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameDateTime(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &GroupMembersResource{}
+var _ resource.ResourceWithImportState = &GroupMembersResource{}
+
+func NewGroupMembersResource() resource.Resource {
+	return &GroupMembersResource{}
+}
+
+// GroupMembersResource defines the resource implementation.
+type GroupMembersResource struct {
+	client *msgraph.Client
+}
+
+// GroupMembersResourceModel describes the resource data model.
+type GroupMembersResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	GroupObjectID   types.String `tfsdk:"group_object_id"`
+	MemberObjectIDs types.Set    `tfsdk:"member_object_ids"`
+}
+
+func (r *GroupMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+func (r *GroupMembersResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: fmt.Sprintf("Authoritative group members resource, members not listed are removed. Members are added in batches of %d", msgraph.MaxGroupMembersPerRequest),
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"group_object_id": {
+				MarkdownDescription: "Group Object ID, dynamic membership groups are not supported",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"member_object_ids": {
+				MarkdownDescription: "Object IDs of all direct members of the group, an empty set removes every member",
+				Required:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+		},
+	}, nil
+}
+
+func (r *GroupMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *GroupMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncMembers(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *GroupMembersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	members, err := r.client.ListGroupMembers(data.GroupObjectID.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("group %s not found, removing from state", data.GroupObjectID.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group members, got error: %s", err))
+		return
+	}
+
+	memberIDs := make([]string, 0)
+	for i := 0; i < len(members); i++ {
+		memberIDs = append(memberIDs, members[i].ID)
+	}
+	data.MemberObjectIDs = stringsToSet(memberIDs)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *GroupMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncMembers(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *GroupMembersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := setToStrings(ctx, data.MemberObjectIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.RemoveGroupMembers(data.GroupObjectID.Value, members)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove group members, got error: %s", err))
+		return
+	}
+}
+
+func (r *GroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_object_id"), req.ID)...)
+}
+
+// syncMembers diffs the current members of the group against the desired
+// members, additions and removals are sent in batches.
+func (r *GroupMembersResource) syncMembers(ctx context.Context, data *GroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	desired, d := setToStrings(ctx, data.MemberObjectIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	r.client.GraphAccess()
+	members, err := r.client.ListGroupMembers(data.GroupObjectID.Value)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read group members, got error: %s", err))
+		return diags
+	}
+
+	current := make(map[string]bool)
+	for i := 0; i < len(members); i++ {
+		current[members[i].ID] = true
+	}
+
+	additions := make([]string, 0)
+	for i := 0; i < len(desired); i++ {
+		if !current[desired[i]] {
+			additions = append(additions, desired[i])
+		}
+	}
+
+	err = r.client.AddGroupMembers(data.GroupObjectID.Value, additions)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to add group members, got error: %s", err))
+		return diags
+	}
+	tflog.Trace(ctx, fmt.Sprintf("added %d group members", len(additions)))
+
+	wanted := make(map[string]bool)
+	for i := 0; i < len(desired); i++ {
+		wanted[desired[i]] = true
+	}
+
+	removals := make([]string, 0)
+	for i := 0; i < len(members); i++ {
+		if !wanted[members[i].ID] {
+			removals = append(removals, members[i].ID)
+		}
+	}
+
+	err = r.client.RemoveGroupMembers(data.GroupObjectID.Value, removals)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to remove group members, got error: %s", err))
+		return diags
+	}
+	tflog.Trace(ctx, fmt.Sprintf("removed %d group members", len(removals)))
+
+	data.Id = types.String{Value: data.GroupObjectID.Value}
+
+	return diags
+}
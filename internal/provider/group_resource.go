@@ -0,0 +1,477 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &GroupResource{}
+var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithValidateConfig = &GroupResource{}
+
+const (
+	groupTypeUnified           = "Unified"
+	groupTypeDynamicMembership = "DynamicMembership"
+)
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+// GroupResource defines the resource implementation.
+type GroupResource struct {
+	client *msgraph.Client
+}
+
+// GroupResourceModel describes the resource data model.
+type GroupResourceModel struct {
+	Id                            types.String `tfsdk:"id"`
+	DisplayName                   types.String `tfsdk:"display_name"`
+	Description                   types.String `tfsdk:"description"`
+	MailNickname                  types.String `tfsdk:"mail_nickname"`
+	MailEnabled                   types.Bool   `tfsdk:"mail_enabled"`
+	SecurityEnabled               types.Bool   `tfsdk:"security_enabled"`
+	Types                         types.Set    `tfsdk:"types"`
+	MembershipRule                types.String `tfsdk:"membership_rule"`
+	MembershipRuleProcessingState types.String `tfsdk:"membership_rule_processing_state"`
+	IsAssignableToRole            types.Bool   `tfsdk:"is_assignable_to_role"`
+	Visibility                    types.String `tfsdk:"visibility"`
+	Owners                        types.Set    `tfsdk:"owners"`
+	Mail                          types.String `tfsdk:"mail"`
+}
+
+func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Group resource, creates a security group or a Microsoft 365 group with assigned or dynamic membership",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Group Object ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"description": {
+				MarkdownDescription: "Description",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"mail_nickname": {
+				MarkdownDescription: "Mail alias, required even when the group is not mail enabled",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"mail_enabled": {
+				MarkdownDescription: "Whether the group is mail enabled, defaults to `true` for Microsoft 365 groups and `false` otherwise. Mail enabled security groups and distribution groups cannot be created with the graph api",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Type: types.BoolType,
+			},
+			"security_enabled": {
+				MarkdownDescription: "Whether the group is a security group, defaults to `false` for Microsoft 365 groups and `true` otherwise",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.BoolType,
+			},
+			"types": {
+				MarkdownDescription: "Group types, `Unified` creates a Microsoft 365 group and `DynamicMembership` enables `membership_rule`. Adding or removing `Unified` replaces the group",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, _ path.Path) (bool, diag.Diagnostics) {
+						stateTypes, diags := groupTypesValue(ctx, state)
+						configTypes, d := groupTypesValue(ctx, config)
+						diags.Append(d...)
+						return containsString(stateTypes, groupTypeUnified) != containsString(configTypes, groupTypeUnified), diags
+					}, "Replaces the group when the Unified group type changes.", "Replaces the group when the `Unified` group type changes."),
+				},
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"membership_rule": {
+				MarkdownDescription: "Dynamic membership rule, e.g. `user.department -eq \"Platform\"`. Requires the `DynamicMembership` group type",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"membership_rule_processing_state": {
+				MarkdownDescription: "`On` or `Paused`, the graph api processes the rule when not set",
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("On", "Paused"),
+				},
+				Type: types.StringType,
+			},
+			"is_assignable_to_role": {
+				MarkdownDescription: "Whether directory roles can be assigned to the group, can only be set when the group is created",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Type: types.BoolType,
+			},
+			"visibility": {
+				MarkdownDescription: "`Private`, `Public` or `HiddenMembership`. `HiddenMembership` is only supported by Microsoft 365 groups and can only be set when the group is created",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, _ path.Path) (bool, diag.Diagnostics) {
+						hidden := types.String{Value: "HiddenMembership"}
+						return state.Equal(hidden) || config.Equal(hidden), nil
+					}, "Replaces the group when the visibility changes from or to HiddenMembership.", "Replaces the group when the visibility changes from or to `HiddenMembership`."),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("Private", "Public", "HiddenMembership"),
+				},
+				Type: types.StringType,
+			},
+			"owners": {
+				MarkdownDescription: "Object IDs of the owners, bound when the group is created. Owners added or removed in the configuration afterwards are added or removed, owners managed outside of it are left untouched",
+				Optional:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"mail": {
+				Computed:            true,
+				MarkdownDescription: "Primary SMTP address of mail enabled groups",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *GroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Types.IsUnknown() {
+		return
+	}
+
+	groupTypes, diags := setToStrings(ctx, data.Types)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i := 0; i < len(groupTypes); i++ {
+		if groupTypes[i] != groupTypeUnified && groupTypes[i] != groupTypeDynamicMembership {
+			resp.Diagnostics.AddAttributeError(path.Root("types"), "Invalid Attribute Value", fmt.Sprintf("%q is not valid, value must be one of: %s, %s.", groupTypes[i], groupTypeUnified, groupTypeDynamicMembership))
+		}
+	}
+
+	unified := containsString(groupTypes, groupTypeUnified)
+	dynamic := containsString(groupTypes, groupTypeDynamicMembership)
+
+	if unified && !data.MailEnabled.IsNull() && !data.MailEnabled.IsUnknown() && !data.MailEnabled.Value {
+		resp.Diagnostics.AddAttributeError(path.Root("mail_enabled"), "Invalid Attribute Combination", "Microsoft 365 groups must be mail enabled.")
+	}
+	if !unified && !data.MailEnabled.IsNull() && !data.MailEnabled.IsUnknown() && data.MailEnabled.Value {
+		resp.Diagnostics.AddAttributeError(path.Root("mail_enabled"), "Invalid Attribute Combination", "Mail enabled security groups and distribution groups cannot be created with the graph api, add the Unified group type for a Microsoft 365 group.")
+	}
+	if !unified && !data.SecurityEnabled.IsNull() && !data.SecurityEnabled.IsUnknown() && !data.SecurityEnabled.Value {
+		resp.Diagnostics.AddAttributeError(path.Root("security_enabled"), "Invalid Attribute Combination", "Groups without the Unified group type must be security enabled.")
+	}
+
+	if dynamic && data.MembershipRule.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("membership_rule"), "Missing Attribute", "membership_rule must be set for the DynamicMembership group type.")
+	}
+	if !dynamic && !data.MembershipRule.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("membership_rule"), "Invalid Attribute Combination", "membership_rule requires the DynamicMembership group type.")
+	}
+	if !dynamic && !data.MembershipRuleProcessingState.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("membership_rule_processing_state"), "Invalid Attribute Combination", "membership_rule_processing_state requires the DynamicMembership group type.")
+	}
+
+	if !unified && data.Visibility.Value == "HiddenMembership" {
+		resp.Diagnostics.AddAttributeError(path.Root("visibility"), "Invalid Attribute Combination", "HiddenMembership is only supported by Microsoft 365 groups.")
+	}
+
+	if data.IsAssignableToRole.Value {
+		if dynamic {
+			resp.Diagnostics.AddAttributeError(path.Root("is_assignable_to_role"), "Invalid Attribute Combination", "Groups assignable to roles cannot have dynamic membership.")
+		}
+		if !data.SecurityEnabled.IsNull() && !data.SecurityEnabled.IsUnknown() && !data.SecurityEnabled.Value {
+			resp.Diagnostics.AddAttributeError(path.Root("is_assignable_to_role"), "Invalid Attribute Combination", "Groups assignable to roles must be security enabled.")
+		}
+		if !data.Visibility.IsNull() && !data.Visibility.IsUnknown() && data.Visibility.Value != "Private" {
+			resp.Diagnostics.AddAttributeError(path.Root("is_assignable_to_role"), "Invalid Attribute Combination", "Groups assignable to roles must be Private.")
+		}
+	}
+}
+
+func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *GroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := expandGroup(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	owners, diags := setToStrings(ctx, data.Owners)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unified := containsString(group.GroupTypes, groupTypeUnified)
+	if data.MailEnabled.IsUnknown() {
+		group.MailEnabled = unified
+	}
+	if data.SecurityEnabled.IsUnknown() {
+		group.SecurityEnabled = !unified
+	}
+	if !data.IsAssignableToRole.IsUnknown() {
+		group.IsAssignableToRole = &data.IsAssignableToRole.Value
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateGroup(group, owners)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, fmt.Sprintf("created group %s", created.ID))
+
+	// The create response does not contain every selected property.
+	created, err = r.client.GetGroup(created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	flattenGroup(data, created)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *GroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	group, err := r.client.GetGroup(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("group %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	flattenGroup(data, group)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *GroupResourceModel
+	var state *GroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := expandGroup(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	owners, diags := setToStrings(ctx, data.Owners)
+	resp.Diagnostics.Append(diags...)
+	stateOwners, diags := setToStrings(ctx, state.Owners)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	group.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateGroup(group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update group, got error: %s", err))
+		return
+	}
+
+	// Add owners before removing any, a group keeps at least one owner
+	// once it has one.
+	for i := 0; i < len(owners); i++ {
+		if containsString(stateOwners, owners[i]) {
+			continue
+		}
+		err = r.client.AddGroupOwner(data.Id.Value, owners[i])
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add group owner %s, got error: %s", owners[i], err))
+			return
+		}
+	}
+	for i := 0; i < len(stateOwners); i++ {
+		if containsString(owners, stateOwners[i]) {
+			continue
+		}
+		err = r.client.RemoveGroupOwner(data.Id.Value, stateOwners[i])
+		if err != nil && !msgraph.IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove group owner %s, got error: %s", stateOwners[i], err))
+			return
+		}
+	}
+
+	updated, err := r.client.GetGroup(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		return
+	}
+
+	flattenGroup(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *GroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteGroup(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group, got error: %s", err))
+		return
+	}
+}
+
+func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// groupTypesValue converts the types attribute value passed to plan
+// modifiers into a string slice.
+func groupTypesValue(ctx context.Context, value attr.Value) ([]string, diag.Diagnostics) {
+	var set types.Set
+	diags := tfsdk.ValueAs(ctx, value, &set)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return setToStrings(ctx, set)
+}
+
+func expandGroup(ctx context.Context, data *GroupResourceModel) (msgraph.Group, diag.Diagnostics) {
+	groupTypes, diags := setToStrings(ctx, data.Types)
+
+	return msgraph.Group{
+		DisplayName:                   data.DisplayName.Value,
+		Description:                   stringPointer(data.Description),
+		MailNickname:                  data.MailNickname.Value,
+		MailEnabled:                   data.MailEnabled.Value,
+		SecurityEnabled:               data.SecurityEnabled.Value,
+		GroupTypes:                    groupTypes,
+		MembershipRule:                stringPointer(data.MembershipRule),
+		MembershipRuleProcessingState: stringPointer(data.MembershipRuleProcessingState),
+		Visibility:                    stringPointer(data.Visibility),
+	}, diags
+}
+
+// flattenGroup updates the model from the graph api. Owners are only
+// managed through the configuration and the rule processing state is only
+// tracked when configured, the graph api defaults it to On.
+func flattenGroup(data *GroupResourceModel, group *msgraph.Group) {
+	data.Id = types.String{Value: group.ID}
+	data.DisplayName = types.String{Value: group.DisplayName}
+	data.Description = optionalStringPointer(group.Description)
+	data.MailNickname = types.String{Value: group.MailNickname}
+	data.MailEnabled = types.Bool{Value: group.MailEnabled}
+	data.SecurityEnabled = types.Bool{Value: group.SecurityEnabled}
+	data.MembershipRule = optionalStringPointer(group.MembershipRule)
+	data.Visibility = optionalStringPointer(group.Visibility)
+	data.Mail = types.String{Value: group.Mail}
+
+	if len(group.GroupTypes) > 0 || !data.Types.IsNull() {
+		data.Types = stringsToSet(group.GroupTypes)
+	}
+	if !data.MembershipRuleProcessingState.IsNull() {
+		data.MembershipRuleProcessingState = optionalStringPointer(group.MembershipRuleProcessingState)
+	}
+
+	data.IsAssignableToRole = types.Bool{Value: false}
+	if group.IsAssignableToRole != nil {
+		data.IsAssignableToRole = types.Bool{Value: *group.IsAssignableToRole}
+	}
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &GroupTransitiveMembersDataSource{}
+
+var groupMemberTypes = []string{"user", "group", "servicePrincipal", "device"}
+
+func NewGroupTransitiveMembersDataSource() datasource.DataSource {
+	return &GroupTransitiveMembersDataSource{}
+}
+
+// GroupTransitiveMembersDataSource defines the data source implementation.
+type GroupTransitiveMembersDataSource struct {
+	client *msgraph.Client
+}
+
+// GroupTransitiveMembersDataSourceModel describes the data source data model.
+type GroupTransitiveMembersDataSourceModel struct {
+	Id            types.String           `tfsdk:"id"`
+	GroupObjectID types.String           `tfsdk:"group_object_id"`
+	Types         types.Set              `tfsdk:"types"`
+	ObjectIDs     types.List             `tfsdk:"object_ids"`
+	Members       []DirectoryObjectModel `tfsdk:"members"`
+}
+
+// DirectoryObjectModel describes a directory object returned by the
+// membership data sources.
+type DirectoryObjectModel struct {
+	ObjectID    types.String `tfsdk:"object_id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func (d *GroupTransitiveMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_transitive_members"
+}
+
+// directoryObjectAttributes describes the directory objects returned by the
+// membership data sources.
+func directoryObjectAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"object_id": {
+			MarkdownDescription: "Object ID",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+		"display_name": {
+			MarkdownDescription: "Display name",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+		"type": {
+			MarkdownDescription: "Object type, e.g. `user` or `group`",
+			Computed:            true,
+			Type:                types.StringType,
+		},
+	}
+}
+
+func (d *GroupTransitiveMembersDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Group transitive members data source, lists the direct and nested members of a group",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "identifier",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"group_object_id": {
+				MarkdownDescription: "Group Object ID",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"types": {
+				MarkdownDescription: fmt.Sprintf("Member types to return, any of `%s`. All members are returned when not set", strings.Join(groupMemberTypes, "`, `")),
+				Optional:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"object_ids": {
+				MarkdownDescription: "Object IDs of the matching members",
+				Computed:            true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"members": {
+				MarkdownDescription: "Matching members",
+				Computed:            true,
+				Attributes:          tfsdk.ListNestedAttributes(directoryObjectAttributes()),
+			},
+		},
+	}, nil
+}
+
+func (d *GroupTransitiveMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupTransitiveMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupTransitiveMembersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectTypes, diags := directoryObjectTypes(ctx, data.Types, groupMemberTypes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client.GraphAccess()
+	members, err := d.client.ListGroupTransitiveMembers(data.GroupObjectID.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list group transitive members, got error: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: data.GroupObjectID.Value}
+	data.ObjectIDs, data.Members = flattenDirectoryObjects(members, objectTypes)
+
+	tflog.Trace(ctx, fmt.Sprintf("listed %d transitive members of group %s", len(data.Members), data.GroupObjectID.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// directoryObjectTypes reads and validates the types filter, an empty slice
+// matches every type.
+func directoryObjectTypes(ctx context.Context, set types.Set, accepted []string) ([]string, diag.Diagnostics) {
+	objectTypes, diags := setToStrings(ctx, set)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i := 0; i < len(objectTypes); i++ {
+		if !containsString(accepted, objectTypes[i]) {
+			diags.AddAttributeError(path.Root("types"), "Invalid Attribute Value", fmt.Sprintf("%q is not valid, value must be one of: %s.", objectTypes[i], strings.Join(accepted, ", ")))
+		}
+	}
+
+	return objectTypes, diags
+}
+
+// flattenDirectoryObjects keeps the objects of the given types, or every
+// object when no types are given.
+func flattenDirectoryObjects(objects []msgraph.DirectoryObject, objectTypes []string) (types.List, []DirectoryObjectModel) {
+	objectIDs := make([]string, 0)
+	models := make([]DirectoryObjectModel, 0)
+	for i := 0; i < len(objects); i++ {
+		object := objects[i]
+		if len(objectTypes) > 0 && !containsString(objectTypes, object.Type()) {
+			continue
+		}
+
+		objectIDs = append(objectIDs, object.ID)
+		models = append(models, DirectoryObjectModel{
+			ObjectID:    types.String{Value: object.ID},
+			DisplayName: optionalString(object.DisplayName),
+			Type:        types.String{Value: object.Type()},
+		})
+	}
+
+	return stringsToList(objectIDs), models
+}
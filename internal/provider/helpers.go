@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringsToList converts a string slice into a terraform list of strings.
+func stringsToList(values []string) types.List {
+	elems := make([]attr.Value, 0)
+	for i := 0; i < len(values); i++ {
+		elems = append(elems, types.String{Value: values[i]})
+	}
+
+	return types.List{
+		Elems:    elems,
+		ElemType: types.StringType,
+	}
+}
+
+// listToStrings converts a terraform list of strings into a string slice.
+func listToStrings(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	values := make([]string, 0)
+	if list.IsNull() || list.IsUnknown() {
+		return values, nil
+	}
+
+	diags := list.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// optionalString returns a null string for empty values so optional
+// attributes left out of the configuration do not show a diff.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.String{Null: true}
+	}
+
+	return types.String{Value: value}
+}
+
+// optionalStringPointer is optionalString for nullable graph api fields.
+func optionalStringPointer(value *string) types.String {
+	if value == nil {
+		return types.String{Null: true}
+	}
+
+	return optionalString(*value)
+}
+
+// stringPointer returns nil for null or unknown strings, so they are sent
+// as null to the graph api.
+func stringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return &value.Value
+}
+
+// parseCompositeID splits an identifier of the form "a/b" into its parts.
+func parseCompositeID(id string, parts int) ([]string, error) {
+	values := strings.Split(id, "/")
+	if len(values) != parts {
+		return nil, fmt.Errorf("expected an identifier with %d parts separated by \"/\", got: %q", parts, id)
+	}
+
+	for i := 0; i < len(values); i++ {
+		if values[i] == "" {
+			return nil, fmt.Errorf("identifier %q contains an empty part", id)
+		}
+	}
+
+	return values, nil
+}
+
+// stringsToSet converts a string slice into a terraform set of strings.
+func stringsToSet(values []string) types.Set {
+	elems := make([]attr.Value, 0)
+	for i := 0; i < len(values); i++ {
+		elems = append(elems, types.String{Value: values[i]})
+	}
+
+	return types.Set{
+		Elems:    elems,
+		ElemType: types.StringType,
+	}
+}
+
+// setToStrings converts a terraform set of strings into a string slice.
+func setToStrings(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	values := make([]string, 0)
+	if set.IsNull() || set.IsUnknown() {
+		return values, nil
+	}
+
+	diags := set.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// containsString reports whether value is present in values.
+func containsString(values []string, value string) bool {
+	for i := 0; i < len(values); i++ {
+		if values[i] == value {
+			return true
+		}
+	}
+	return false
+}
+
+// optionalStringsToSet is stringsToSet returning a null set for empty
+// slices, the graph api returns empty collections for unset properties.
+func optionalStringsToSet(values []string) types.Set {
+	if len(values) == 0 {
+		return types.Set{Null: true, ElemType: types.StringType}
+	}
+
+	return stringsToSet(values)
+}
+
+// optionalBool converts a nullable graph api flag, false is kept null when
+// prior is null so optional flags left out of the configuration do not show
+// a diff.
+func optionalBool(value *bool, prior types.Bool) types.Bool {
+	if value == nil || (!*value && prior.IsNull()) {
+		return types.Bool{Null: true}
+	}
+
+	return types.Bool{Value: *value}
+}
+
+// computedBool returns the flag reported by the graph api for a computed
+// attribute, a flag that is not returned is false.
+func computedBool(value *bool) types.Bool {
+	return types.Bool{Value: value != nil && *value}
+}
+
+// boolPointer returns nil for null or unknown flags, so they are left out of
+// the payload.
+func boolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return &value.Value
+}
+
+// int64Pointer returns nil for null or unknown numbers, so they are left out
+// of the payload.
+func int64Pointer(value types.Int64) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return &value.Value
+}
+
+// configuredInt64 converts a graph api setting that always has a default, it
+// is only tracked when prior is not null.
+func configuredInt64(value *int64, prior types.Int64) types.Int64 {
+	if value == nil || prior.IsNull() {
+		return types.Int64{Null: true}
+	}
+
+	return types.Int64{Value: *value}
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal, the
+// graph api does not preserve the formatting of JSON strings.
+func jsonEqual(a string, b string) bool {
+	var left, right interface{}
+	if json.Unmarshal([]byte(a), &left) != nil || json.Unmarshal([]byte(b), &right) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(left, right)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCompositeID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		parts   int
+		want    []string
+		wantErr bool
+	}{
+		{name: "two parts", id: "app/credential", parts: 2, want: []string{"app", "credential"}},
+		{name: "three parts", id: "a/b/c", parts: 3, want: []string{"a", "b", "c"}},
+		{name: "too few parts", id: "app", parts: 2, wantErr: true},
+		{name: "too many parts", id: "a/b/c", parts: 2, wantErr: true},
+		{name: "empty id", id: "", parts: 2, wantErr: true},
+		{name: "empty first part", id: "/credential", parts: 2, wantErr: true},
+		{name: "empty last part", id: "app/", parts: 2, wantErr: true},
+		{name: "only separator", id: "/", parts: 2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCompositeID(tt.id, tt.parts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCompositeID(%q, %d) returned error %v, want error %t", tt.id, tt.parts, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseCompositeID(%q, %d) = %q, want %q", tt.id, tt.parts, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &InvitationResource{}
+
+func NewInvitationResource() resource.Resource {
+	return &InvitationResource{}
+}
+
+// InvitationResource defines the resource implementation.
+type InvitationResource struct {
+	client *msgraph.Client
+}
+
+// InvitationResourceModel describes the resource data model.
+type InvitationResourceModel struct {
+	Id                        types.String            `tfsdk:"id"`
+	EmailAddress              types.String            `tfsdk:"email_address"`
+	DisplayName               types.String            `tfsdk:"display_name"`
+	RedirectURL               types.String            `tfsdk:"redirect_url"`
+	UserType                  types.String            `tfsdk:"user_type"`
+	SuppressInvitationMessage types.Bool              `tfsdk:"suppress_invitation_message"`
+	Message                   *InvitationMessageModel `tfsdk:"message"`
+	DeleteUserOnDestroy       types.Bool              `tfsdk:"delete_user_on_destroy"`
+	UserID                    types.String            `tfsdk:"user_id"`
+	RedeemURL                 types.String            `tfsdk:"redeem_url"`
+}
+
+// InvitationMessageModel describes the customized invitation email.
+type InvitationMessageModel struct {
+	Body         types.String `tfsdk:"body"`
+	Language     types.String `tfsdk:"language"`
+	CcRecipients types.Set    `tfsdk:"cc_recipients"`
+}
+
+func (r *InvitationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invitation"
+}
+
+func (r *InvitationResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Invitation resource, invites an external user and creates the guest user. The guest user is kept on destroy unless `delete_user_on_destroy` is set",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Invitation ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"email_address": {
+				MarkdownDescription: "Email address of the invited user",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name of the invited user",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"redirect_url": {
+				MarkdownDescription: "URL the user is redirected to after redeeming the invitation, e.g. `https://myapps.microsoft.com`",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"user_type": {
+				MarkdownDescription: "`Guest` or `Member`, defaults to `Guest`",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("Guest", "Member"),
+				},
+				Type: types.StringType,
+			},
+			"suppress_invitation_message": {
+				MarkdownDescription: "Whether the invitation email is not sent, the redeem URL has to be shared with the user instead",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.BoolType,
+			},
+			"message": {
+				MarkdownDescription: "Customization of the invitation email",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"body": {
+						MarkdownDescription: "Custom message added to the email",
+						Optional:            true,
+						Type:                types.StringType,
+					},
+					"language": {
+						MarkdownDescription: "Language of the email, e.g. `de-DE`. Defaults to `en-US`",
+						Optional:            true,
+						Type:                types.StringType,
+					},
+					"cc_recipients": {
+						MarkdownDescription: "Email address receiving a copy of the email, the graph api supports a single recipient",
+						Optional:            true,
+						Type: types.SetType{
+							ElemType: types.StringType,
+						},
+					},
+				}),
+			},
+			"delete_user_on_destroy": {
+				MarkdownDescription: "Whether the guest user is deleted when the resource is destroyed",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"user_id": {
+				Computed:            true,
+				MarkdownDescription: "Object ID of the invited user",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"redeem_url": {
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "URL the user can use to redeem the invitation",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *InvitationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *InvitationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invitation := msgraph.Invitation{
+		InvitedUserEmailAddress: data.EmailAddress.Value,
+		InvitedUserDisplayName:  stringPointer(data.DisplayName),
+		InvitedUserType:         stringPointer(data.UserType),
+		InviteRedirectURL:       data.RedirectURL.Value,
+		SendInvitationMessage:   !data.SuppressInvitationMessage.Value,
+	}
+	if data.Message != nil {
+		recipients, diags := setToStrings(ctx, data.Message.CcRecipients)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		invitation.InvitedUserMessageInfo = &msgraph.InvitedUserMessageInfo{
+			CustomizedMessageBody: stringPointer(data.Message.Body),
+			MessageLanguage:       stringPointer(data.Message.Language),
+		}
+		for i := 0; i < len(recipients); i++ {
+			invitation.InvitedUserMessageInfo.CcRecipients = append(invitation.InvitedUserMessageInfo.CcRecipients, msgraph.Recipient{
+				EmailAddress: msgraph.EmailAddress{Address: recipients[i]},
+			})
+		}
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateInvitation(invitation)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create invitation, got error: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: created.ID}
+	data.RedeemURL = types.String{Value: created.InviteRedeemURL}
+	data.UserID = types.String{Null: true}
+	if created.InvitedUser != nil {
+		data.UserID = types.String{Value: created.InvitedUser.ID}
+	}
+	tflog.Trace(ctx, fmt.Sprintf("created invitation %s for user %s", data.Id.Value, data.UserID.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *InvitationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Invitations cannot be read back, the resource follows the invited user.
+	r.client.GraphAccess()
+	_, err := r.client.GetUser(data.UserID.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("invited user %s not found, removing from state", data.UserID.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read invited user, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *InvitationResourceModel
+
+	// Only delete_user_on_destroy can change in place, the plan is saved as is.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *InvitationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DeleteUserOnDestroy.Value {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteUser(data.UserID.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete invited user, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &NamedLocationResource{}
+var _ resource.ResourceWithImportState = &NamedLocationResource{}
+var _ resource.ResourceWithValidateConfig = &NamedLocationResource{}
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+func NewNamedLocationResource() resource.Resource {
+	return &NamedLocationResource{}
+}
+
+// NamedLocationResource defines the resource implementation.
+type NamedLocationResource struct {
+	client *msgraph.Client
+}
+
+// NamedLocationResourceModel describes the resource data model.
+type NamedLocationResourceModel struct {
+	Id          types.String               `tfsdk:"id"`
+	DisplayName types.String               `tfsdk:"display_name"`
+	IP          *IPNamedLocationModel      `tfsdk:"ip"`
+	Country     *CountryNamedLocationModel `tfsdk:"country"`
+}
+
+// IPNamedLocationModel describes a location defined by IP ranges.
+type IPNamedLocationModel struct {
+	IPRanges  types.Set  `tfsdk:"ip_ranges"`
+	IsTrusted types.Bool `tfsdk:"is_trusted"`
+}
+
+// CountryNamedLocationModel describes a location defined by countries.
+type CountryNamedLocationModel struct {
+	CountriesAndRegions               types.Set  `tfsdk:"countries_and_regions"`
+	IncludeUnknownCountriesAndRegions types.Bool `tfsdk:"include_unknown_countries_and_regions"`
+}
+
+func (r *NamedLocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_named_location"
+}
+
+// requiresReplaceOnPresenceChange replaces the resource when a nested
+// attribute is added or removed, e.g. when a named location changes type.
+func requiresReplaceOnPresenceChange() tfsdk.AttributePlanModifier {
+	return resource.RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, _ path.Path) (bool, diag.Diagnostics) {
+		return state.IsNull() != config.IsNull(), nil
+	}, "Replaces the resource when the attribute is added or removed.", "Replaces the resource when the attribute is added or removed.")
+}
+
+func (r *NamedLocationResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Named location resource, defines a Conditional Access location by IP ranges or by countries. Exactly one of `ip` or `country` must be set",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Named location ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"ip": {
+				MarkdownDescription: "IP ranges of the location",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					requiresReplaceOnPresenceChange(),
+				},
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"ip_ranges": {
+						MarkdownDescription: "IPv4 and IPv6 ranges in CIDR notation, e.g. `203.0.113.0/24`",
+						Required:            true,
+						Type: types.SetType{
+							ElemType: types.StringType,
+						},
+					},
+					"is_trusted": {
+						MarkdownDescription: "Whether the location is trusted",
+						Optional:            true,
+						Type:                types.BoolType,
+					},
+				}),
+			},
+			"country": {
+				MarkdownDescription: "Countries and regions of the location",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					requiresReplaceOnPresenceChange(),
+				},
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"countries_and_regions": {
+						MarkdownDescription: "Two letter ISO 3166 country codes, e.g. `DE`",
+						Required:            true,
+						Type: types.SetType{
+							ElemType: types.StringType,
+						},
+					},
+					"include_unknown_countries_and_regions": {
+						MarkdownDescription: "Whether IP addresses that cannot be mapped to a country are included",
+						Optional:            true,
+						Type:                types.BoolType,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r *NamedLocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NamedLocationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (data.IP == nil) == (data.Country == nil) {
+		resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of ip or country must be set.")
+		return
+	}
+
+	if data.IP != nil {
+		ranges, diags := setToStrings(ctx, data.IP.IPRanges)
+		resp.Diagnostics.Append(diags...)
+		for i := 0; i < len(ranges); i++ {
+			if _, _, err := net.ParseCIDR(ranges[i]); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("ip").AtName("ip_ranges"), "Invalid Attribute Value", fmt.Sprintf("%q is not a valid CIDR range: %s", ranges[i], err))
+			}
+		}
+	}
+
+	if data.Country != nil {
+		countries, diags := setToStrings(ctx, data.Country.CountriesAndRegions)
+		resp.Diagnostics.Append(diags...)
+		for i := 0; i < len(countries); i++ {
+			if !countryCodePattern.MatchString(countries[i]) {
+				resp.Diagnostics.AddAttributeError(path.Root("country").AtName("countries_and_regions"), "Invalid Attribute Value", fmt.Sprintf("%q is not a valid two letter country code.", countries[i]))
+			}
+		}
+	}
+}
+
+func (r *NamedLocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NamedLocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NamedLocationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, diags := expandNamedLocation(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateNamedLocation(location)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create named location, got error: %s", err))
+		return
+	}
+
+	flattenNamedLocation(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created named location %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NamedLocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NamedLocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	location, err := r.client.GetNamedLocation(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("named location %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read named location, got error: %s", err))
+		return
+	}
+
+	flattenNamedLocation(data, location)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NamedLocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NamedLocationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, diags := expandNamedLocation(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	location.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateNamedLocation(location)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update named location, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetNamedLocation(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read named location, got error: %s", err))
+		return
+	}
+
+	flattenNamedLocation(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NamedLocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NamedLocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteNamedLocation(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete named location, got error: %s", err))
+		return
+	}
+}
+
+func (r *NamedLocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandNamedLocation(ctx context.Context, data *NamedLocationResourceModel) (msgraph.NamedLocation, diag.Diagnostics) {
+	location := msgraph.NamedLocation{
+		DisplayName: data.DisplayName.Value,
+	}
+
+	if data.IP != nil {
+		ranges, diags := setToStrings(ctx, data.IP.IPRanges)
+
+		location.Odata_type = msgraph.NamedLocationTypeIP
+		location.IsTrusted = &data.IP.IsTrusted.Value
+		for i := 0; i < len(ranges); i++ {
+			rangeType := msgraph.IPRangeTypeV6
+			if ip, _, err := net.ParseCIDR(ranges[i]); err == nil && ip.To4() != nil {
+				rangeType = msgraph.IPRangeTypeV4
+			}
+			location.IPRanges = append(location.IPRanges, msgraph.IPRange{Odata_type: rangeType, CidrAddress: ranges[i]})
+		}
+
+		return location, diags
+	}
+
+	countries, diags := setToStrings(ctx, data.Country.CountriesAndRegions)
+
+	location.Odata_type = msgraph.NamedLocationTypeCountry
+	location.CountriesAndRegions = countries
+	location.IncludeUnknownCountriesAndRegions = &data.Country.IncludeUnknownCountriesAndRegions.Value
+
+	return location, diags
+}
+
+// flattenNamedLocation updates the model from the graph api, the @odata.type
+// decides which of ip or country is set. Flags the graph api reports as
+// false are kept null when they are not configured.
+func flattenNamedLocation(data *NamedLocationResourceModel, location *msgraph.NamedLocation) {
+	data.Id = types.String{Value: location.ID}
+	data.DisplayName = types.String{Value: location.DisplayName}
+
+	switch location.Odata_type {
+	case msgraph.NamedLocationTypeIP:
+		ranges := make([]string, 0)
+		for i := 0; i < len(location.IPRanges); i++ {
+			ranges = append(ranges, location.IPRanges[i].CidrAddress)
+		}
+
+		prior := types.Bool{Null: true}
+		if data.IP != nil {
+			prior = data.IP.IsTrusted
+		}
+		data.IP = &IPNamedLocationModel{
+			IPRanges:  stringsToSet(ranges),
+			IsTrusted: optionalBool(location.IsTrusted, prior),
+		}
+		data.Country = nil
+	case msgraph.NamedLocationTypeCountry:
+		prior := types.Bool{Null: true}
+		if data.Country != nil {
+			prior = data.Country.IncludeUnknownCountriesAndRegions
+		}
+		data.Country = &CountryNamedLocationModel{
+			CountriesAndRegions:               stringsToSet(location.CountriesAndRegions),
+			IncludeUnknownCountriesAndRegions: optionalBool(location.IncludeUnknownCountriesAndRegions, prior),
+		}
+		data.IP = nil
+	}
+}
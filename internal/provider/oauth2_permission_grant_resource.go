@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &OAuth2PermissionGrantResource{}
+var _ resource.ResourceWithImportState = &OAuth2PermissionGrantResource{}
+var _ resource.ResourceWithValidateConfig = &OAuth2PermissionGrantResource{}
+
+// oauth2PermissionGrantLock serializes the read-modify-write of grant scopes
+// when several resources contribute scopes to the same grant.
+var oauth2PermissionGrantLock sync.Mutex
+
+func NewOAuth2PermissionGrantResource() resource.Resource {
+	return &OAuth2PermissionGrantResource{}
+}
+
+// OAuth2PermissionGrantResource defines the resource implementation.
+type OAuth2PermissionGrantResource struct {
+	client *msgraph.Client
+}
+
+// OAuth2PermissionGrantResourceModel describes the resource data model.
+type OAuth2PermissionGrantResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	ClientObjectID    types.String `tfsdk:"client_object_id"`
+	ResourceObjectID  types.String `tfsdk:"resource_object_id"`
+	ConsentType       types.String `tfsdk:"consent_type"`
+	PrincipalObjectID types.String `tfsdk:"principal_object_id"`
+	Scopes            types.Set    `tfsdk:"scopes"`
+	AddedScopes       types.Set    `tfsdk:"added_scopes"`
+}
+
+func (r *OAuth2PermissionGrantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth2_permission_grant"
+}
+
+func (r *OAuth2PermissionGrantResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Delegated permission grant resource, consents to delegated scopes of a resource for a client. Several resources can contribute scopes to the same grant, each one only removes the scopes it added. Scopes that were already granted are left in place, a scope declared by several resources is removed by the resource that added it",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Grant ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"client_object_id": {
+				MarkdownDescription: "Object ID of the client service principal the consent is given to",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"resource_object_id": {
+				MarkdownDescription: "Object ID of the resource service principal publishing the scopes, e.g. Microsoft Graph",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"consent_type": {
+				MarkdownDescription: "`AllPrincipals` for tenant-wide admin consent or `Principal` for a single user",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("AllPrincipals", "Principal"),
+				},
+			},
+			"principal_object_id": {
+				MarkdownDescription: "Object ID of the user consent is given for, required when `consent_type` is `Principal`",
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"scopes": {
+				MarkdownDescription: "Delegated scopes contributed to the grant, e.g. `User.Read`",
+				Required:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"added_scopes": {
+				Computed:            true,
+				MarkdownDescription: "Scopes added to the grant by this resource, only these are removed again. An imported grant owns all of its scopes",
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+		},
+	}, nil
+}
+
+func (r *OAuth2PermissionGrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OAuth2PermissionGrantResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ConsentType.IsUnknown() {
+		return
+	}
+
+	if data.ConsentType.Value == "Principal" && data.PrincipalObjectID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("principal_object_id"), "Missing Attribute", "principal_object_id is required when consent_type is Principal.")
+	}
+	if data.ConsentType.Value == "AllPrincipals" && !data.PrincipalObjectID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("principal_object_id"), "Conflicting Attributes", "principal_object_id cannot be set when consent_type is AllPrincipals.")
+	}
+}
+
+func (r *OAuth2PermissionGrantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OAuth2PermissionGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *OAuth2PermissionGrantResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopes, diags := setToStrings(ctx, data.Scopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oauth2PermissionGrantLock.Lock()
+	defer oauth2PermissionGrantLock.Unlock()
+
+	r.client.GraphAccess()
+	grant, err := r.client.FindOAuth2PermissionGrant(data.ClientObjectID.Value, data.ResourceObjectID.Value, data.ConsentType.Value, data.PrincipalObjectID.Value)
+	switch {
+	case msgraph.IsNotFound(err):
+		grant, err = r.client.CreateOAuth2PermissionGrant(msgraph.OAuth2PermissionGrant{
+			ClientID:    data.ClientObjectID.Value,
+			ConsentType: data.ConsentType.Value,
+			PrincipalID: stringPointer(data.PrincipalObjectID),
+			ResourceID:  data.ResourceObjectID.Value,
+			Scope:       msgraph.MergeScopes("", scopes),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create oauth2 permission grant, got error: %s", err))
+			return
+		}
+		data.AddedScopes = data.Scopes
+		tflog.Trace(ctx, fmt.Sprintf("created oauth2 permission grant %s", grant.ID))
+	case err != nil:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read oauth2 permission grants, got error: %s", err))
+		return
+	default:
+		data.AddedScopes = stringsToSet(missingScopes(grant.Scope, scopes))
+		err = r.client.UpdateOAuth2PermissionGrantScope(grant.ID, msgraph.MergeScopes(grant.Scope, scopes))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add scopes to oauth2 permission grant, got error: %s", err))
+			return
+		}
+		tflog.Trace(ctx, fmt.Sprintf("added scopes to oauth2 permission grant %s", grant.ID))
+	}
+
+	data.Id = types.String{Value: grant.ID}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OAuth2PermissionGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OAuth2PermissionGrantResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	grant, err := r.client.GetOAuth2PermissionGrant(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("oauth2 permission grant %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read oauth2 permission grant, got error: %s", err))
+		return
+	}
+
+	scopes, diags := setToStrings(ctx, data.Scopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the scopes contributed by this resource are tracked, an imported
+	// grant only has an ID and takes all of its scopes.
+	imported := data.ClientObjectID.IsNull()
+	grantScopes := msgraph.SplitScopes(grant.Scope)
+	present := make([]string, 0)
+	for i := 0; i < len(grantScopes); i++ {
+		if imported || containsString(scopes, grantScopes[i]) {
+			present = append(present, grantScopes[i])
+		}
+	}
+
+	// The remaining scopes belong to other resources, they are not taken
+	// over when the scopes of this resource were removed elsewhere.
+	if len(present) == 0 && !imported {
+		tflog.Trace(ctx, fmt.Sprintf("scopes of oauth2 permission grant %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Imported grants own all of their scopes, as do grants tracked before
+	// the added scopes were recorded.
+	added := present
+	if !imported && !data.AddedScopes.IsNull() {
+		owned, diags := setToStrings(ctx, data.AddedScopes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		added = make([]string, 0)
+		for i := 0; i < len(owned); i++ {
+			if containsString(present, owned[i]) {
+				added = append(added, owned[i])
+			}
+		}
+	}
+
+	data.ClientObjectID = types.String{Value: grant.ClientID}
+	data.ResourceObjectID = types.String{Value: grant.ResourceID}
+	data.ConsentType = types.String{Value: grant.ConsentType}
+	data.PrincipalObjectID = optionalStringPointer(grant.PrincipalID)
+	data.Scopes = stringsToSet(present)
+	data.AddedScopes = stringsToSet(added)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OAuth2PermissionGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *OAuth2PermissionGrantResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *OAuth2PermissionGrantResourceModel
+	// Read Terraform State data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopes, diags := setToStrings(ctx, data.Scopes)
+	resp.Diagnostics.Append(diags...)
+	owned, diags := ownedScopes(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only scopes this resource added are removed, the others were granted
+	// before or by another resource.
+	removed := make([]string, 0)
+	kept := make([]string, 0)
+	for i := 0; i < len(owned); i++ {
+		if containsString(scopes, owned[i]) {
+			kept = append(kept, owned[i])
+		} else {
+			removed = append(removed, owned[i])
+		}
+	}
+
+	oauth2PermissionGrantLock.Lock()
+	defer oauth2PermissionGrantLock.Unlock()
+
+	r.client.GraphAccess()
+	grant, err := r.client.GetOAuth2PermissionGrant(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read oauth2 permission grant, got error: %s", err))
+		return
+	}
+
+	data.AddedScopes = stringsToSet(append(kept, missingScopes(grant.Scope, scopes)...))
+	scope := msgraph.RemoveScopes(msgraph.MergeScopes(grant.Scope, scopes), removed)
+	err = r.client.UpdateOAuth2PermissionGrantScope(grant.ID, scope)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update oauth2 permission grant scopes, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, fmt.Sprintf("updated oauth2 permission grant %s scopes to %q", grant.ID, scope))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OAuth2PermissionGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OAuth2PermissionGrantResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scopes, diags := ownedScopes(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oauth2PermissionGrantLock.Lock()
+	defer oauth2PermissionGrantLock.Unlock()
+
+	r.client.GraphAccess()
+	grant, err := r.client.GetOAuth2PermissionGrant(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read oauth2 permission grant, got error: %s", err))
+		return
+	}
+
+	// The grant itself is only deleted once no other scopes are left on it.
+	scope := msgraph.RemoveScopes(grant.Scope, scopes)
+	if scope == "" {
+		err = r.client.DeleteOAuth2PermissionGrant(grant.ID)
+	} else {
+		err = r.client.UpdateOAuth2PermissionGrantScope(grant.ID, scope)
+	}
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove scopes from oauth2 permission grant, got error: %s", err))
+		return
+	}
+}
+
+func (r *OAuth2PermissionGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ownedScopes returns the scopes a resource added to its grant, state
+// without added scopes owns all of its scopes.
+func ownedScopes(ctx context.Context, data *OAuth2PermissionGrantResourceModel) ([]string, diag.Diagnostics) {
+	if data.AddedScopes.IsNull() || data.AddedScopes.IsUnknown() {
+		return setToStrings(ctx, data.Scopes)
+	}
+
+	return setToStrings(ctx, data.AddedScopes)
+}
+
+// missingScopes returns the scopes that are not yet part of the space
+// separated scope of a grant.
+func missingScopes(scope string, scopes []string) []string {
+	current := msgraph.SplitScopes(scope)
+	missing := make([]string, 0)
+	for i := 0; i < len(scopes); i++ {
+		if !containsString(current, scopes[i]) {
+			missing = append(missing, scopes[i])
+		}
+	}
+
+	return missing
+}
@@ -132,12 +132,56 @@ func (p *MsgraphProvider) Configure(ctx context.Context, req provider.ConfigureR
 func (p *MsgraphProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewApplicationWebResource,
+		NewApplicationFederatedIdentityCredentialResource,
+		NewApplicationOwnerResource,
+		NewApplicationOwnersResource,
+		NewApplicationOptionalClaimsResource,
+		NewApplicationBrandingResource,
+		NewServicePrincipalResource,
+		NewAppRoleAssignmentResource,
+		NewOAuth2PermissionGrantResource,
+		NewUserResource,
+		NewGroupResource,
+		NewGroupMemberResource,
+		NewGroupMembersResource,
+		NewDirectoryRoleAssignmentResource,
+		NewDirectoryRoleDefinitionResource,
+		NewDirectoryRoleEligibilityScheduleRequestResource,
+		NewAdministrativeUnitResource,
+		NewAdministrativeUnitMemberResource,
+		NewAdministrativeUnitRoleMemberResource,
+		NewConditionalAccessPolicyResource,
+		NewNamedLocationResource,
+		NewAuthenticationStrengthPolicyResource,
+		NewAuthenticationMethodConfigurationResource,
+		NewClaimsMappingPolicyResource,
+		NewClaimsMappingPolicyAssignmentResource,
+		NewTokenLifetimePolicyResource,
+		NewTokenLifetimePolicyAssignmentResource,
+		NewHomeRealmDiscoveryPolicyResource,
+		NewHomeRealmDiscoveryPolicyAssignmentResource,
+		NewApplicationExtensionPropertyResource,
+		NewSchemaExtensionResource,
+		NewAttributeSetResource,
+		NewCustomSecurityAttributeDefinitionResource,
+		NewCustomSecurityAttributeAssignmentResource,
+		NewInvitationResource,
+		NewCrossTenantAccessDefaultResource,
+		NewCrossTenantAccessPartnerResource,
+		NewCrossTenantIdentitySynchronizationResource,
 	}
 }
 
 func (p *MsgraphProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewApplicationWebDataSource,
+		NewServicePrincipalDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewGroupTransitiveMembersDataSource,
+		NewUserTransitiveMemberOfDataSource,
+		NewDirectoryRoleDefinitionsDataSource,
+		NewAuthenticationStrengthPolicyDataSource,
 	}
 }
 
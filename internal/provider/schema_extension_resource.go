@@ -0,0 +1,433 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SchemaExtensionResource{}
+var _ resource.ResourceWithImportState = &SchemaExtensionResource{}
+var _ resource.ResourceWithModifyPlan = &SchemaExtensionResource{}
+
+var schemaExtensionTargetTypePattern = regexp.MustCompile(`^(User|Group|AdministrativeUnit|Contact|Device|Event|Message|Organization|Post)$`)
+
+func NewSchemaExtensionResource() resource.Resource {
+	return &SchemaExtensionResource{}
+}
+
+// SchemaExtensionResource defines the resource implementation.
+type SchemaExtensionResource struct {
+	client *msgraph.Client
+}
+
+// SchemaExtensionResourceModel describes the resource data model.
+type SchemaExtensionResourceModel struct {
+	Id          types.String                   `tfsdk:"id"`
+	Name        types.String                   `tfsdk:"name"`
+	Description types.String                   `tfsdk:"description"`
+	Owner       types.String                   `tfsdk:"owner"`
+	TargetTypes types.Set                      `tfsdk:"target_types"`
+	Properties  []SchemaExtensionPropertyModel `tfsdk:"properties"`
+	Status      types.String                   `tfsdk:"status"`
+}
+
+// SchemaExtensionPropertyModel describes a property of a schema extension.
+type SchemaExtensionPropertyModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (r *SchemaExtensionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_extension"
+}
+
+func (r *SchemaExtensionResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Schema extension resource, defines a typed extension of directory objects. The status moves from `InDevelopment` to `Available` to `Deprecated` and never back. Once `Available`, properties and target types can only be added and destroying the resource deprecates the extension instead of deleting it",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Schema extension ID, the name prefixed with a verified domain or a generated `ext` prefix",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"name": {
+				MarkdownDescription: "Requested ID, e.g. `contoso_employee` with the prefix of a verified domain or `employee` to have the graph api generate a prefix",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"description": {
+				MarkdownDescription: "Description",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"owner": {
+				MarkdownDescription: "Application (client) ID of the application owning the extension",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"target_types": {
+				MarkdownDescription: "Object types the extension can be set on, e.g. `User` or `Group`",
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					stringSetMatches(schemaExtensionTargetTypePattern, "values must be one of: User, Group, AdministrativeUnit, Contact, Device, Event, Message, Organization, Post"),
+				},
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"properties": {
+				MarkdownDescription: "Properties of the extension",
+				Required:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"name": {
+						MarkdownDescription: "Property name",
+						Required:            true,
+						Type:                types.StringType,
+					},
+					"type": {
+						MarkdownDescription: "`Binary`, `Boolean`, `DateTime`, `Integer` or `String`",
+						Required:            true,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf("Binary", "Boolean", "DateTime", "Integer", "String"),
+						},
+						Type: types.StringType,
+					},
+				}),
+			},
+			"status": {
+				MarkdownDescription: "`InDevelopment`, `Available` or `Deprecated`, defaults to `InDevelopment`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(msgraph.SchemaExtensionStatuses...),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+// ModifyPlan enforces the lifecycle of the schema extension, the status
+// cannot move back and published extensions can only be extended.
+func (r *SchemaExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to enforce when the resource is created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SchemaExtensionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Status.IsUnknown() && schemaExtensionStatusIndex(plan.Status.Value) < schemaExtensionStatusIndex(state.Status.Value) {
+		resp.Diagnostics.AddAttributeError(path.Root("status"), "Invalid Status Change", fmt.Sprintf("The status of a schema extension cannot move back from %s to %s.", state.Status.Value, plan.Status.Value))
+	}
+
+	if state.Status.Value == msgraph.SchemaExtensionStatusInDevelopment {
+		return
+	}
+
+	for _, property := range state.Properties {
+		found := false
+		for _, planned := range plan.Properties {
+			if planned.Name.Equal(property.Name) && planned.Type.Equal(property.Type) {
+				found = true
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "Invalid Property Change", fmt.Sprintf("Property %s cannot be removed or changed once the schema extension is %s.", property.Name.Value, state.Status.Value))
+		}
+	}
+
+	if plan.TargetTypes.IsUnknown() {
+		return
+	}
+	planned, diags := setToStrings(ctx, plan.TargetTypes)
+	resp.Diagnostics.Append(diags...)
+	current, diags := setToStrings(ctx, state.TargetTypes)
+	resp.Diagnostics.Append(diags...)
+	for i := 0; i < len(current); i++ {
+		if !containsString(planned, current[i]) {
+			resp.Diagnostics.AddAttributeError(path.Root("target_types"), "Invalid Target Type Change", fmt.Sprintf("Target type %s cannot be removed once the schema extension is %s.", current[i], state.Status.Value))
+		}
+	}
+}
+
+func (r *SchemaExtensionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SchemaExtensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SchemaExtensionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension, diags := expandSchemaExtension(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extension.ID = data.Name.Value
+	status := data.Status
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateSchemaExtension(extension)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schema extension, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, fmt.Sprintf("created schema extension %s", created.ID))
+
+	// Save the extension before publishing it, so a failed status change
+	// does not leave an untracked extension behind.
+	flattenSchemaExtension(data, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if !status.IsUnknown() && !status.IsNull() {
+		extension.ID = created.ID
+		resp.Diagnostics.Append(r.advanceStatus(extension, created.Status, status.Value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updated, err := r.client.GetSchemaExtension(created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema extension, got error: %s", err))
+		return
+	}
+
+	flattenSchemaExtension(data, updated)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaExtensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SchemaExtensionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	extension, err := r.client.GetSchemaExtension(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("schema extension %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema extension, got error: %s", err))
+		return
+	}
+
+	flattenSchemaExtension(data, extension)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaExtensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SchemaExtensionResourceModel
+	var state *SchemaExtensionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension, diags := expandSchemaExtension(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extension.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateSchemaExtension(extension)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema extension, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.advanceStatus(extension, state.Status.Value, data.Status.Value)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.GetSchemaExtension(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema extension, got error: %s", err))
+		return
+	}
+
+	flattenSchemaExtension(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SchemaExtensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SchemaExtensionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	if data.Status.Value == msgraph.SchemaExtensionStatusInDevelopment {
+		err := r.client.DeleteSchemaExtension(data.Id.Value)
+		if err != nil && !msgraph.IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schema extension, got error: %s", err))
+		}
+		return
+	}
+
+	// Published extensions cannot be deleted, they are deprecated instead
+	// and only dropped from the state.
+	extension, diags := expandSchemaExtension(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extension.ID = data.Id.Value
+
+	resp.Diagnostics.Append(r.advanceStatus(extension, data.Status.Value, msgraph.SchemaExtensionStatusDeprecated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Schema extension deprecated",
+		fmt.Sprintf("Schema extension %s was deprecated instead of deleted, only extensions that are InDevelopment can be deleted.", data.Id.Value),
+	)
+}
+
+func (r *SchemaExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// advanceStatus moves the extension forward one status at a time, the graph
+// api does not skip states of the lifecycle.
+func (r *SchemaExtensionResource) advanceStatus(extension msgraph.SchemaExtension, from string, to string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i := schemaExtensionStatusIndex(from) + 1; i <= schemaExtensionStatusIndex(to); i++ {
+		extension.Status = msgraph.SchemaExtensionStatuses[i]
+		err := r.client.UpdateSchemaExtension(extension)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to change schema extension status to %s, got error: %s", extension.Status, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func schemaExtensionStatusIndex(status string) int {
+	for i := 0; i < len(msgraph.SchemaExtensionStatuses); i++ {
+		if msgraph.SchemaExtensionStatuses[i] == status {
+			return i
+		}
+	}
+	return 0
+}
+
+func expandSchemaExtension(ctx context.Context, data *SchemaExtensionResourceModel) (msgraph.SchemaExtension, diag.Diagnostics) {
+	targetTypes, diags := setToStrings(ctx, data.TargetTypes)
+
+	extension := msgraph.SchemaExtension{
+		Description: data.Description.Value,
+		Owner:       data.Owner.Value,
+		TargetTypes: targetTypes,
+		Properties:  make([]msgraph.ExtensionSchemaProperty, 0),
+	}
+	for i := 0; i < len(data.Properties); i++ {
+		extension.Properties = append(extension.Properties, msgraph.ExtensionSchemaProperty{
+			Name: data.Properties[i].Name.Value,
+			Type: data.Properties[i].Type.Value,
+		})
+	}
+
+	return extension, diags
+}
+
+// flattenSchemaExtension updates the model from the graph api, the name is
+// taken from the ID when the extension is imported.
+func flattenSchemaExtension(data *SchemaExtensionResourceModel, extension *msgraph.SchemaExtension) {
+	data.Id = types.String{Value: extension.ID}
+	data.Description = optionalString(extension.Description)
+	data.Owner = types.String{Value: extension.Owner}
+	data.TargetTypes = stringsToSet(extension.TargetTypes)
+	data.Status = types.String{Value: extension.Status}
+
+	if data.Name.IsNull() {
+		data.Name = types.String{Value: extension.ID}
+	}
+
+	data.Properties = make([]SchemaExtensionPropertyModel, 0)
+	for i := 0; i < len(extension.Properties); i++ {
+		data.Properties = append(data.Properties, SchemaExtensionPropertyModel{
+			Name: types.String{Value: extension.Properties[i].Name},
+			Type: types.String{Value: extension.Properties[i].Type},
+		})
+	}
+}
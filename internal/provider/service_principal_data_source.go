@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ServicePrincipalDataSource{}
+
+func NewServicePrincipalDataSource() datasource.DataSource {
+	return &ServicePrincipalDataSource{}
+}
+
+// ServicePrincipalDataSource defines the data source implementation.
+type ServicePrincipalDataSource struct {
+	client *msgraph.Client
+}
+
+// ServicePrincipalDataSourceModel describes the data source data model.
+type ServicePrincipalDataSourceModel struct {
+	Id                        types.String                   `tfsdk:"id"`
+	AppID                     types.String                   `tfsdk:"app_id"`
+	ObjectID                  types.String                   `tfsdk:"object_id"`
+	DisplayName               types.String                   `tfsdk:"display_name"`
+	WellKnownApplication      types.String                   `tfsdk:"well_known_application"`
+	AppRoleAssignmentRequired types.Bool                     `tfsdk:"app_role_assignment_required"`
+	Tags                      types.Set                      `tfsdk:"tags"`
+	Notes                     types.String                   `tfsdk:"notes"`
+	PreferredSingleSignOnMode types.String                   `tfsdk:"preferred_single_sign_on_mode"`
+	LoginURL                  types.String                   `tfsdk:"login_url"`
+	ServicePrincipalType      types.String                   `tfsdk:"service_principal_type"`
+	AppOwnerOrganizationID    types.String                   `tfsdk:"app_owner_organization_id"`
+	AppRoles                  []ServicePrincipalAppRoleModel `tfsdk:"app_roles"`
+	OAuth2PermissionScopes    []ServicePrincipalScopeModel   `tfsdk:"oauth2_permission_scopes"`
+}
+
+// ServicePrincipalAppRoleModel describes an app role published by a service principal.
+type ServicePrincipalAppRoleModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Value              types.String `tfsdk:"value"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	Description        types.String `tfsdk:"description"`
+	AllowedMemberTypes types.List   `tfsdk:"allowed_member_types"`
+	IsEnabled          types.Bool   `tfsdk:"is_enabled"`
+}
+
+// ServicePrincipalScopeModel describes a delegated permission published by a service principal.
+type ServicePrincipalScopeModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Value                   types.String `tfsdk:"value"`
+	Type                    types.String `tfsdk:"type"`
+	AdminConsentDisplayName types.String `tfsdk:"admin_consent_display_name"`
+	IsEnabled               types.Bool   `tfsdk:"is_enabled"`
+}
+
+func (d *ServicePrincipalDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_principal"
+}
+
+func (d *ServicePrincipalDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	wellKnown := make([]string, 0)
+	for name := range msgraph.WellKnownApplicationIDs {
+		wellKnown = append(wellKnown, name)
+	}
+	sort.Strings(wellKnown)
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Service principal data source, looks up exactly one of `app_id`, `object_id`, `display_name` or `well_known_application`",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "identifier",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"app_id": {
+				MarkdownDescription: "Application Client ID",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"object_id": {
+				MarkdownDescription: "Service principal Object ID",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name, must be unique in the tenant",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"well_known_application": {
+				MarkdownDescription: fmt.Sprintf("First-party Microsoft application, one of `%s`", strings.Join(wellKnown, "`, `")),
+				Optional:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(wellKnown...),
+				},
+			},
+			"app_role_assignment_required": {
+				MarkdownDescription: "Whether users and groups must be assigned an app role before they can sign in",
+				Computed:            true,
+				Type:                types.BoolType,
+			},
+			"tags": {
+				MarkdownDescription: "Tags of the service principal",
+				Computed:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"notes": {
+				MarkdownDescription: "Free text notes about the service principal",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"preferred_single_sign_on_mode": {
+				MarkdownDescription: "Single sign-on mode",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"login_url": {
+				MarkdownDescription: "URL used by the identity provider to start service provider initiated sign-on",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"service_principal_type": {
+				MarkdownDescription: "Type of the service principal",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"app_owner_organization_id": {
+				MarkdownDescription: "Tenant ID the application is registered in",
+				Computed:            true,
+				Type:                types.StringType,
+			},
+			"app_roles": {
+				MarkdownDescription: "App roles published by the service principal",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "App role ID",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"value": {
+						MarkdownDescription: "Value of the role claim, e.g. `Application.Read.All`",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"display_name": {
+						MarkdownDescription: "Display name",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"description": {
+						MarkdownDescription: "Description",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"allowed_member_types": {
+						MarkdownDescription: "Principal types the role can be assigned to, `User` and/or `Application`",
+						Computed:            true,
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+					},
+					"is_enabled": {
+						MarkdownDescription: "Whether the role is enabled",
+						Computed:            true,
+						Type:                types.BoolType,
+					},
+				}),
+			},
+			"oauth2_permission_scopes": {
+				MarkdownDescription: "Delegated permissions published by the service principal",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "Scope ID",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"value": {
+						MarkdownDescription: "Value of the scope claim, e.g. `User.Read`",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"type": {
+						MarkdownDescription: "`User` or `Admin`, whether users can consent to the scope",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"admin_consent_display_name": {
+						MarkdownDescription: "Display name shown for admin consent",
+						Computed:            true,
+						Type:                types.StringType,
+					},
+					"is_enabled": {
+						MarkdownDescription: "Whether the scope is enabled",
+						Computed:            true,
+						Type:                types.BoolType,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (d *ServicePrincipalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServicePrincipalDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServicePrincipalDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookups := 0
+	for _, value := range []types.String{data.AppID, data.ObjectID, data.DisplayName, data.WellKnownApplication} {
+		if !value.IsNull() {
+			lookups++
+		}
+	}
+	if lookups != 1 {
+		resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of app_id, object_id, display_name or well_known_application must be set.")
+		return
+	}
+
+	d.client.GraphAccess()
+
+	var servicePrincipal *msgraph.ServicePrincipal
+	var err error
+	switch {
+	case !data.ObjectID.IsNull():
+		servicePrincipal, err = d.client.GetServicePrincipal(data.ObjectID.Value)
+	case !data.AppID.IsNull():
+		servicePrincipal, err = d.client.GetServicePrincipalByAppID(data.AppID.Value)
+	case !data.DisplayName.IsNull():
+		servicePrincipal, err = d.client.GetServicePrincipalByDisplayName(data.DisplayName.Value)
+	default:
+		servicePrincipal, err = d.client.GetServicePrincipalByAppID(msgraph.WellKnownApplicationIDs[data.WellKnownApplication.Value])
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service principal, got error: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: servicePrincipal.ID}
+	data.ObjectID = types.String{Value: servicePrincipal.ID}
+	data.AppID = types.String{Value: servicePrincipal.AppID}
+	data.DisplayName = types.String{Value: servicePrincipal.DisplayName}
+	data.AppRoleAssignmentRequired = types.Bool{Value: servicePrincipal.AppRoleAssignmentRequired}
+	data.Tags = stringsToSet(servicePrincipal.Tags)
+	data.Notes = optionalStringPointer(servicePrincipal.Notes)
+	data.PreferredSingleSignOnMode = optionalStringPointer(servicePrincipal.PreferredSingleSignOnMode)
+	data.LoginURL = optionalStringPointer(servicePrincipal.LoginURL)
+	data.ServicePrincipalType = types.String{Value: servicePrincipal.ServicePrincipalType}
+	data.AppOwnerOrganizationID = optionalString(servicePrincipal.AppOwnerOrganizationID)
+
+	data.AppRoles = make([]ServicePrincipalAppRoleModel, 0)
+	for i := 0; i < len(servicePrincipal.AppRoles); i++ {
+		role := servicePrincipal.AppRoles[i]
+		data.AppRoles = append(data.AppRoles, ServicePrincipalAppRoleModel{
+			ID:                 types.String{Value: role.ID},
+			Value:              types.String{Value: role.Value},
+			DisplayName:        types.String{Value: role.DisplayName},
+			Description:        types.String{Value: role.Description},
+			AllowedMemberTypes: stringsToList(role.AllowedMemberTypes),
+			IsEnabled:          types.Bool{Value: role.IsEnabled},
+		})
+	}
+
+	data.OAuth2PermissionScopes = make([]ServicePrincipalScopeModel, 0)
+	for i := 0; i < len(servicePrincipal.OAuth2PermissionScopes); i++ {
+		scope := servicePrincipal.OAuth2PermissionScopes[i]
+		data.OAuth2PermissionScopes = append(data.OAuth2PermissionScopes, ServicePrincipalScopeModel{
+			ID:                      types.String{Value: scope.ID},
+			Value:                   types.String{Value: scope.Value},
+			Type:                    types.String{Value: scope.Type},
+			AdminConsentDisplayName: types.String{Value: scope.AdminConsentDisplayName},
+			IsEnabled:               types.Bool{Value: scope.IsEnabled},
+		})
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read service principal %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ServicePrincipalResource{}
+var _ resource.ResourceWithImportState = &ServicePrincipalResource{}
+
+func NewServicePrincipalResource() resource.Resource {
+	return &ServicePrincipalResource{}
+}
+
+// ServicePrincipalResource defines the resource implementation.
+type ServicePrincipalResource struct {
+	client *msgraph.Client
+}
+
+// ServicePrincipalResourceModel describes the resource data model.
+type ServicePrincipalResourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	AppID                     types.String `tfsdk:"app_id"`
+	DisplayName               types.String `tfsdk:"display_name"`
+	AppRoleAssignmentRequired types.Bool   `tfsdk:"app_role_assignment_required"`
+	Tags                      types.Set    `tfsdk:"tags"`
+	Notes                     types.String `tfsdk:"notes"`
+	PreferredSingleSignOnMode types.String `tfsdk:"preferred_single_sign_on_mode"`
+	LoginURL                  types.String `tfsdk:"login_url"`
+	ServicePrincipalType      types.String `tfsdk:"service_principal_type"`
+}
+
+func (r *ServicePrincipalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_principal"
+}
+
+func (r *ServicePrincipalResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Service principal resource, creates the enterprise application of an application in the tenant",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Service principal Object ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"app_id": {
+				MarkdownDescription: "Application Client ID the service principal is created for",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				Computed:            true,
+				MarkdownDescription: "Display name, taken from the application",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"app_role_assignment_required": {
+				MarkdownDescription: "Whether users and groups must be assigned an app role before they can sign in",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.BoolType,
+			},
+			"tags": {
+				MarkdownDescription: "Tags of the service principal, e.g. `HideApp` or `WindowsAzureActiveDirectoryIntegratedApp`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"notes": {
+				MarkdownDescription: "Free text notes about the service principal",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"preferred_single_sign_on_mode": {
+				MarkdownDescription: "Single sign-on mode, one of `password`, `saml`, `notSupported` or `oidc`",
+				Optional:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("password", "saml", "notSupported", "oidc"),
+				},
+			},
+			"login_url": {
+				MarkdownDescription: "URL used by the identity provider to start service provider initiated sign-on",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"service_principal_type": {
+				Computed:            true,
+				MarkdownDescription: "Type of the service principal, `Application` for service principals created from an application",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *ServicePrincipalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServicePrincipalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ServicePrincipalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	servicePrincipal, diags := expandServicePrincipal(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	servicePrincipal.AppID = data.AppID.Value
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateServicePrincipal(servicePrincipal)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service principal, got error: %s", err))
+		return
+	}
+
+	flattenServicePrincipal(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created service principal %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServicePrincipalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ServicePrincipalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	servicePrincipal, err := r.client.GetServicePrincipal(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("service principal %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service principal, got error: %s", err))
+		return
+	}
+
+	flattenServicePrincipal(data, servicePrincipal)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServicePrincipalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ServicePrincipalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	servicePrincipal, diags := expandServicePrincipal(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	servicePrincipal.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateServicePrincipal(servicePrincipal)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service principal, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetServicePrincipal(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service principal, got error: %s", err))
+		return
+	}
+
+	flattenServicePrincipal(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServicePrincipalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ServicePrincipalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteServicePrincipal(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service principal, got error: %s", err))
+		return
+	}
+}
+
+func (r *ServicePrincipalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandServicePrincipal(ctx context.Context, data *ServicePrincipalResourceModel) (msgraph.ServicePrincipal, diag.Diagnostics) {
+	tags, diags := setToStrings(ctx, data.Tags)
+
+	return msgraph.ServicePrincipal{
+		AppRoleAssignmentRequired: data.AppRoleAssignmentRequired.Value,
+		Tags:                      tags,
+		Notes:                     stringPointer(data.Notes),
+		PreferredSingleSignOnMode: stringPointer(data.PreferredSingleSignOnMode),
+		LoginURL:                  stringPointer(data.LoginURL),
+	}, diags
+}
+
+func flattenServicePrincipal(data *ServicePrincipalResourceModel, servicePrincipal *msgraph.ServicePrincipal) {
+	data.Id = types.String{Value: servicePrincipal.ID}
+	data.AppID = types.String{Value: servicePrincipal.AppID}
+	data.DisplayName = types.String{Value: servicePrincipal.DisplayName}
+	data.AppRoleAssignmentRequired = types.Bool{Value: servicePrincipal.AppRoleAssignmentRequired}
+	data.Tags = stringsToSet(servicePrincipal.Tags)
+	data.Notes = optionalStringPointer(servicePrincipal.Notes)
+	data.PreferredSingleSignOnMode = optionalStringPointer(servicePrincipal.PreferredSingleSignOnMode)
+	data.LoginURL = optionalStringPointer(servicePrincipal.LoginURL)
+	data.ServicePrincipalType = types.String{Value: servicePrincipal.ServicePrincipalType}
+}
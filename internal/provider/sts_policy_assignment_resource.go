@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StsPolicyAssignmentResource{}
+var _ resource.ResourceWithImportState = &StsPolicyAssignmentResource{}
+
+func NewClaimsMappingPolicyAssignmentResource() resource.Resource {
+	return &StsPolicyAssignmentResource{
+		kind:     msgraph.StsPolicyKindClaimsMapping,
+		typeName: "claims_mapping_policy_assignment",
+		name:     "claims mapping policy",
+	}
+}
+
+func NewTokenLifetimePolicyAssignmentResource() resource.Resource {
+	return &StsPolicyAssignmentResource{
+		kind:     msgraph.StsPolicyKindTokenLifetime,
+		typeName: "token_lifetime_policy_assignment",
+		name:     "token lifetime policy",
+	}
+}
+
+func NewHomeRealmDiscoveryPolicyAssignmentResource() resource.Resource {
+	return &StsPolicyAssignmentResource{
+		kind:     msgraph.StsPolicyKindHomeRealmDiscovery,
+		typeName: "home_realm_discovery_policy_assignment",
+		name:     "home realm discovery policy",
+	}
+}
+
+// StsPolicyAssignmentResource defines the resource implementation shared by
+// the policy assignments of service principals.
+type StsPolicyAssignmentResource struct {
+	client   *msgraph.Client
+	kind     msgraph.StsPolicyKind
+	typeName string
+	name     string
+}
+
+// StsPolicyAssignmentResourceModel describes the resource data model.
+type StsPolicyAssignmentResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	ServicePrincipalObjectID types.String `tfsdk:"service_principal_object_id"`
+	PolicyID                 types.String `tfsdk:"policy_id"`
+}
+
+func (r *StsPolicyAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *StsPolicyAssignmentResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: fmt.Sprintf("Assigns a %s to a service principal, a service principal can only have one %s", r.name, r.name),
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "identifier in the form `servicePrincipalObjectId/policyId`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"service_principal_object_id": {
+				MarkdownDescription: "Service Principal Object ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+			"policy_id": {
+				MarkdownDescription: "Policy ID",
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *StsPolicyAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StsPolicyAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StsPolicyAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.AssignServicePrincipalStsPolicy(r.kind, data.ServicePrincipalObjectID.Value, data.PolicyID.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign %s, got error: %s", r.name, err))
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf("%s/%s", data.ServicePrincipalObjectID.Value, data.PolicyID.Value)}
+	tflog.Trace(ctx, fmt.Sprintf("assigned %s %s", r.name, data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StsPolicyAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *StsPolicyAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	present, err := r.client.CheckServicePrincipalStsPolicy(r.kind, data.ServicePrincipalObjectID.Value, data.PolicyID.Value)
+	if msgraph.IsNotFound(err) || (err == nil && !present) {
+		tflog.Trace(ctx, fmt.Sprintf("%s assignment %s not found, removing from state", r.name, data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s assignments, got error: %s", r.name, err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StsPolicyAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *StsPolicyAssignmentResourceModel
+
+	// All attributes require replacement, the plan is saved as is.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StsPolicyAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StsPolicyAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.RemoveServicePrincipalStsPolicy(r.kind, data.ServicePrincipalObjectID.Value, data.PolicyID.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s assignment, got error: %s", r.name, err))
+		return
+	}
+}
+
+func (r *StsPolicyAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := parseCompositeID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected servicePrincipalObjectId/policyId: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_principal_object_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), parts[1])...)
+}
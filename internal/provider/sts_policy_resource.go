@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &StsPolicyResource{}
+var _ resource.ResourceWithImportState = &StsPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &StsPolicyResource{}
+
+func NewClaimsMappingPolicyResource() resource.Resource {
+	return &StsPolicyResource{
+		kind:          msgraph.StsPolicyKindClaimsMapping,
+		typeName:      "claims_mapping_policy",
+		name:          "claims mapping policy",
+		definitionKey: "ClaimsMappingPolicy",
+		description:   "Claims mapping policy resource, customizes the claims emitted in tokens issued to a service principal, e.g. custom SAML claims",
+	}
+}
+
+func NewTokenLifetimePolicyResource() resource.Resource {
+	return &StsPolicyResource{
+		kind:          msgraph.StsPolicyKindTokenLifetime,
+		typeName:      "token_lifetime_policy",
+		name:          "token lifetime policy",
+		definitionKey: "TokenLifetimePolicy",
+		description:   "Token lifetime policy resource, sets the lifetime of access, ID and SAML tokens",
+	}
+}
+
+func NewHomeRealmDiscoveryPolicyResource() resource.Resource {
+	return &StsPolicyResource{
+		kind:          msgraph.StsPolicyKindHomeRealmDiscovery,
+		typeName:      "home_realm_discovery_policy",
+		name:          "home realm discovery policy",
+		definitionKey: "HomeRealmDiscoveryPolicy",
+		description:   "Home realm discovery policy resource, controls the sign-in behavior for federated users",
+	}
+}
+
+// StsPolicyResource defines the resource implementation shared by the claims
+// mapping, token lifetime and home realm discovery policies.
+type StsPolicyResource struct {
+	client        *msgraph.Client
+	kind          msgraph.StsPolicyKind
+	typeName      string
+	name          string
+	definitionKey string
+	description   string
+}
+
+// StsPolicyResourceModel describes the resource data model.
+type StsPolicyResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	DisplayName           types.String `tfsdk:"display_name"`
+	Definition            types.String `tfsdk:"definition"`
+	IsOrganizationDefault types.Bool   `tfsdk:"is_organization_default"`
+}
+
+func (r *StsPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *StsPolicyResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: r.description,
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Policy ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"definition": {
+				MarkdownDescription: fmt.Sprintf("Policy definition, a JSON document with a single `%s` object. Use `jsonencode` to build it", r.definitionKey),
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"is_organization_default": {
+				MarkdownDescription: "Whether the policy applies to every service principal without an assigned policy",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+		},
+	}, nil
+}
+
+func (r *StsPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StsPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Definition.IsNull() || data.Definition.IsUnknown() {
+		return
+	}
+
+	var definition map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data.Definition.Value), &definition); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "Invalid Attribute Value", fmt.Sprintf("definition must be a JSON object: %s", err))
+		return
+	}
+
+	var policy map[string]interface{}
+	if len(definition) != 1 || json.Unmarshal(definition[r.definitionKey], &policy) != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definition"), "Invalid Attribute Value", fmt.Sprintf("definition must contain a single %s object.", r.definitionKey))
+	}
+}
+
+func (r *StsPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StsPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *StsPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateStsPolicy(r.kind, expandStsPolicy(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create %s, got error: %s", r.name, err))
+		return
+	}
+
+	flattenStsPolicy(data, created)
+	tflog.Trace(ctx, fmt.Sprintf("created %s %s", r.name, data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StsPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *StsPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	policy, err := r.client.GetStsPolicy(r.kind, data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("%s %s not found, removing from state", r.name, data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", r.name, err))
+		return
+	}
+
+	flattenStsPolicy(data, policy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StsPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *StsPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy := expandStsPolicy(data)
+	policy.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateStsPolicy(r.kind, policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update %s, got error: %s", r.name, err))
+		return
+	}
+
+	updated, err := r.client.GetStsPolicy(r.kind, data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", r.name, err))
+		return
+	}
+
+	flattenStsPolicy(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StsPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *StsPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteStsPolicy(r.kind, data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s, got error: %s", r.name, err))
+		return
+	}
+}
+
+func (r *StsPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandStsPolicy(data *StsPolicyResourceModel) msgraph.StsPolicy {
+	return msgraph.StsPolicy{
+		DisplayName:           data.DisplayName.Value,
+		Definition:            []string{data.Definition.Value},
+		IsOrganizationDefault: boolPointer(data.IsOrganizationDefault),
+	}
+}
+
+// flattenStsPolicy updates the model from the graph api, the configured
+// definition is kept when it is semantically equal to the stored one.
+func flattenStsPolicy(data *StsPolicyResourceModel, policy *msgraph.StsPolicy) {
+	data.Id = types.String{Value: policy.ID}
+	data.DisplayName = types.String{Value: policy.DisplayName}
+	data.IsOrganizationDefault = optionalBool(policy.IsOrganizationDefault, data.IsOrganizationDefault)
+
+	if len(policy.Definition) > 0 && !jsonEqual(data.Definition.Value, policy.Definition[0]) {
+		data.Definition = types.String{Value: policy.Definition[0]}
+	}
+}
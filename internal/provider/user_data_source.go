@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *msgraph.Client
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	ObjectID          types.String `tfsdk:"object_id"`
+	UserPrincipalName types.String `tfsdk:"user_principal_name"`
+	Mail              types.String `tfsdk:"mail"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	MailNickname      types.String `tfsdk:"mail_nickname"`
+	AccountEnabled    types.Bool   `tfsdk:"account_enabled"`
+	UserType          types.String `tfsdk:"user_type"`
+	GivenName         types.String `tfsdk:"given_name"`
+	Surname           types.String `tfsdk:"surname"`
+	UsageLocation     types.String `tfsdk:"usage_location"`
+	Department        types.String `tfsdk:"department"`
+	JobTitle          types.String `tfsdk:"job_title"`
+	CompanyName       types.String `tfsdk:"company_name"`
+	OfficeLocation    types.String `tfsdk:"office_location"`
+	MobilePhone       types.String `tfsdk:"mobile_phone"`
+	EmployeeID        types.String `tfsdk:"employee_id"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// userComputedAttributes describes the read-only user properties shared by
+// the user data sources.
+func userComputedAttributes() map[string]tfsdk.Attribute {
+	attributes := map[string]tfsdk.Attribute{
+		"display_name":    {MarkdownDescription: "Display name"},
+		"mail_nickname":   {MarkdownDescription: "Mail alias"},
+		"user_type":       {MarkdownDescription: "`Member` or `Guest`"},
+		"given_name":      {MarkdownDescription: "Given name"},
+		"surname":         {MarkdownDescription: "Surname"},
+		"usage_location":  {MarkdownDescription: "Two letter country code"},
+		"department":      {MarkdownDescription: "Department"},
+		"job_title":       {MarkdownDescription: "Job title"},
+		"company_name":    {MarkdownDescription: "Company name"},
+		"office_location": {MarkdownDescription: "Office location"},
+		"mobile_phone":    {MarkdownDescription: "Mobile phone number"},
+		"employee_id":     {MarkdownDescription: "Employee identifier assigned by the organization"},
+	}
+	for name, attribute := range attributes {
+		attribute.Computed = true
+		attribute.Type = types.StringType
+		attributes[name] = attribute
+	}
+
+	attributes["account_enabled"] = tfsdk.Attribute{
+		MarkdownDescription: "Whether the account is enabled",
+		Computed:            true,
+		Type:                types.BoolType,
+	}
+
+	return attributes
+}
+
+func (d *UserDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := userComputedAttributes()
+	attributes["id"] = tfsdk.Attribute{
+		MarkdownDescription: "identifier",
+		Type:                types.StringType,
+		Computed:            true,
+	}
+	attributes["object_id"] = tfsdk.Attribute{
+		MarkdownDescription: "User Object ID",
+		Optional:            true,
+		Computed:            true,
+		Type:                types.StringType,
+	}
+	attributes["user_principal_name"] = tfsdk.Attribute{
+		MarkdownDescription: "User principal name",
+		Optional:            true,
+		Computed:            true,
+		Type:                types.StringType,
+	}
+	attributes["mail"] = tfsdk.Attribute{
+		MarkdownDescription: "Primary SMTP address",
+		Optional:            true,
+		Computed:            true,
+		Type:                types.StringType,
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User data source, looks up exactly one of `object_id`, `user_principal_name` or `mail`",
+
+		Attributes: attributes,
+	}, nil
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookups := 0
+	for _, value := range []types.String{data.ObjectID, data.UserPrincipalName, data.Mail} {
+		if !value.IsNull() {
+			lookups++
+		}
+	}
+	if lookups != 1 {
+		resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of object_id, user_principal_name or mail must be set.")
+		return
+	}
+
+	d.client.GraphAccess()
+
+	var user *msgraph.User
+	var err error
+	switch {
+	case !data.ObjectID.IsNull():
+		user, err = d.client.GetUser(data.ObjectID.Value)
+	case !data.UserPrincipalName.IsNull():
+		user, err = d.client.GetUser(data.UserPrincipalName.Value)
+	default:
+		user, err = d.client.GetUserByMail(data.Mail.Value)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: user.ID}
+	data.ObjectID = types.String{Value: user.ID}
+	data.UserPrincipalName = types.String{Value: user.UserPrincipalName}
+	data.Mail = optionalString(user.Mail)
+	data.DisplayName = types.String{Value: user.DisplayName}
+	data.MailNickname = types.String{Value: user.MailNickname}
+	data.AccountEnabled = types.Bool{Value: user.AccountEnabled}
+	data.UserType = types.String{Value: user.UserType}
+	data.GivenName = optionalStringPointer(user.GivenName)
+	data.Surname = optionalStringPointer(user.Surname)
+	data.UsageLocation = optionalStringPointer(user.UsageLocation)
+	data.Department = optionalStringPointer(user.Department)
+	data.JobTitle = optionalStringPointer(user.JobTitle)
+	data.CompanyName = optionalStringPointer(user.CompanyName)
+	data.OfficeLocation = optionalStringPointer(user.OfficeLocation)
+	data.MobilePhone = optionalStringPointer(user.MobilePhone)
+	data.EmployeeID = optionalStringPointer(user.EmployeeID)
+
+	tflog.Trace(ctx, fmt.Sprintf("read user %s", data.Id.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
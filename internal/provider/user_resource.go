@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	client *msgraph.Client
+}
+
+// UserResourceModel describes the resource data model.
+type UserResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	UserPrincipalName   types.String `tfsdk:"user_principal_name"`
+	DisplayName         types.String `tfsdk:"display_name"`
+	MailNickname        types.String `tfsdk:"mail_nickname"`
+	Password            types.String `tfsdk:"password"`
+	ForcePasswordChange types.Bool   `tfsdk:"force_password_change"`
+	AccountEnabled      types.Bool   `tfsdk:"account_enabled"`
+	GivenName           types.String `tfsdk:"given_name"`
+	Surname             types.String `tfsdk:"surname"`
+	UsageLocation       types.String `tfsdk:"usage_location"`
+	Department          types.String `tfsdk:"department"`
+	JobTitle            types.String `tfsdk:"job_title"`
+	CompanyName         types.String `tfsdk:"company_name"`
+	OfficeLocation      types.String `tfsdk:"office_location"`
+	MobilePhone         types.String `tfsdk:"mobile_phone"`
+	EmployeeID          types.String `tfsdk:"employee_id"`
+	Mail                types.String `tfsdk:"mail"`
+	UserType            types.String `tfsdk:"user_type"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User resource, creates a cloud-only user",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "User Object ID",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"user_principal_name": {
+				MarkdownDescription: "User principal name, e.g. `jane@contoso.com`",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"display_name": {
+				MarkdownDescription: "Display name",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"mail_nickname": {
+				MarkdownDescription: "Mail alias",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"password": {
+				MarkdownDescription: "Initial password, only sent when the user is created. Changing it afterwards has no effect",
+				Required:            true,
+				Sensitive:           true,
+				Type:                types.StringType,
+			},
+			"force_password_change": {
+				MarkdownDescription: "Whether the user must change the password on the next sign-in, only sent when the user is created",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.BoolType,
+			},
+			"account_enabled": {
+				MarkdownDescription: "Whether the account is enabled, defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.BoolType,
+			},
+			"given_name": {
+				MarkdownDescription: "Given name",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"surname": {
+				MarkdownDescription: "Surname",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"usage_location": {
+				MarkdownDescription: "Two letter country code, required to assign licenses",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"department": {
+				MarkdownDescription: "Department",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"job_title": {
+				MarkdownDescription: "Job title",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"company_name": {
+				MarkdownDescription: "Company name",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"office_location": {
+				MarkdownDescription: "Office location",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"mobile_phone": {
+				MarkdownDescription: "Mobile phone number",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"employee_id": {
+				MarkdownDescription: "Employee identifier assigned by the organization",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"mail": {
+				Computed:            true,
+				MarkdownDescription: "Primary SMTP address",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+			"user_type": {
+				Computed:            true,
+				MarkdownDescription: "`Member` or `Guest`",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *UserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AccountEnabled.IsUnknown() {
+		data.AccountEnabled = types.Bool{Value: true}
+	}
+	if data.ForcePasswordChange.IsUnknown() {
+		data.ForcePasswordChange = types.Bool{Value: false}
+	}
+
+	user := expandUser(data)
+	user.PasswordProfile = &msgraph.PasswordProfile{
+		Password:                      data.Password.Value,
+		ForceChangePasswordNextSignIn: data.ForcePasswordChange.Value,
+	}
+
+	r.client.GraphAccess()
+	created, err := r.client.CreateUser(user)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, fmt.Sprintf("created user %s", created.ID))
+
+	// The create response only contains the default properties.
+	created, err = r.client.GetUser(created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	flattenUser(data, created)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	user, err := r.client.GetUser(data.Id.Value)
+	if msgraph.IsNotFound(err) {
+		tflog.Trace(ctx, fmt.Sprintf("user %s not found, removing from state", data.Id.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	flattenUser(data, user)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *UserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := expandUser(data)
+	user.ID = data.Id.Value
+
+	r.client.GraphAccess()
+	err := r.client.UpdateUser(user)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user, got error: %s", err))
+		return
+	}
+
+	updated, err := r.client.GetUser(data.Id.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	flattenUser(data, updated)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *UserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.GraphAccess()
+	err := r.client.DeleteUser(data.Id.Value)
+	if err != nil && !msgraph.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user, got error: %s", err))
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandUser(data *UserResourceModel) msgraph.User {
+	return msgraph.User{
+		DisplayName:       data.DisplayName.Value,
+		UserPrincipalName: data.UserPrincipalName.Value,
+		MailNickname:      data.MailNickname.Value,
+		AccountEnabled:    data.AccountEnabled.Value,
+		GivenName:         stringPointer(data.GivenName),
+		Surname:           stringPointer(data.Surname),
+		UsageLocation:     stringPointer(data.UsageLocation),
+		Department:        stringPointer(data.Department),
+		JobTitle:          stringPointer(data.JobTitle),
+		CompanyName:       stringPointer(data.CompanyName),
+		OfficeLocation:    stringPointer(data.OfficeLocation),
+		MobilePhone:       stringPointer(data.MobilePhone),
+		EmployeeID:        stringPointer(data.EmployeeID),
+	}
+}
+
+// flattenUser updates the model from the graph api, the password is write
+// only and kept as configured.
+func flattenUser(data *UserResourceModel, user *msgraph.User) {
+	data.Id = types.String{Value: user.ID}
+	data.UserPrincipalName = types.String{Value: user.UserPrincipalName}
+	data.DisplayName = types.String{Value: user.DisplayName}
+	data.MailNickname = types.String{Value: user.MailNickname}
+	data.AccountEnabled = types.Bool{Value: user.AccountEnabled}
+	data.GivenName = optionalStringPointer(user.GivenName)
+	data.Surname = optionalStringPointer(user.Surname)
+	data.UsageLocation = optionalStringPointer(user.UsageLocation)
+	data.Department = optionalStringPointer(user.Department)
+	data.JobTitle = optionalStringPointer(user.JobTitle)
+	data.CompanyName = optionalStringPointer(user.CompanyName)
+	data.OfficeLocation = optionalStringPointer(user.OfficeLocation)
+	data.MobilePhone = optionalStringPointer(user.MobilePhone)
+	data.EmployeeID = optionalStringPointer(user.EmployeeID)
+	data.Mail = types.String{Value: user.Mail}
+	data.UserType = types.String{Value: user.UserType}
+
+	if data.ForcePasswordChange.IsNull() || data.ForcePasswordChange.IsUnknown() {
+		data.ForcePasswordChange = types.Bool{Value: false}
+	}
+}
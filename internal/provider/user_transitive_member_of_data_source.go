@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &UserTransitiveMemberOfDataSource{}
+
+var memberOfTypes = []string{"group", "directoryRole", "administrativeUnit"}
+
+func NewUserTransitiveMemberOfDataSource() datasource.DataSource {
+	return &UserTransitiveMemberOfDataSource{}
+}
+
+// UserTransitiveMemberOfDataSource defines the data source implementation.
+type UserTransitiveMemberOfDataSource struct {
+	client *msgraph.Client
+}
+
+// UserTransitiveMemberOfDataSourceModel describes the data source data model.
+type UserTransitiveMemberOfDataSourceModel struct {
+	Id           types.String           `tfsdk:"id"`
+	UserObjectID types.String           `tfsdk:"user_object_id"`
+	Types        types.Set              `tfsdk:"types"`
+	ObjectIDs    types.List             `tfsdk:"object_ids"`
+	MemberOf     []DirectoryObjectModel `tfsdk:"member_of"`
+}
+
+func (d *UserTransitiveMemberOfDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_transitive_member_of"
+}
+
+func (d *UserTransitiveMemberOfDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User transitive membership data source, lists the groups, directory roles and administrative units a user is a direct or nested member of",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "identifier",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"user_object_id": {
+				MarkdownDescription: "User Object ID or user principal name",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"types": {
+				MarkdownDescription: fmt.Sprintf("Object types to return, any of `%s`. All objects are returned when not set", strings.Join(memberOfTypes, "`, `")),
+				Optional:            true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"object_ids": {
+				MarkdownDescription: "Object IDs of the matching objects",
+				Computed:            true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"member_of": {
+				MarkdownDescription: "Matching objects the user is a member of",
+				Computed:            true,
+				Attributes:          tfsdk.ListNestedAttributes(directoryObjectAttributes()),
+			},
+		},
+	}, nil
+}
+
+func (d *UserTransitiveMemberOfDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserTransitiveMemberOfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserTransitiveMemberOfDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectTypes, diags := directoryObjectTypes(ctx, data.Types, memberOfTypes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client.GraphAccess()
+	memberOf, err := d.client.ListUserTransitiveMemberOf(data.UserObjectID.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list user transitive memberships, got error: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: data.UserObjectID.Value}
+	data.ObjectIDs, data.MemberOf = flattenDirectoryObjects(memberOf, objectTypes)
+
+	tflog.Trace(ctx, fmt.Sprintf("listed %d transitive memberships of user %s", len(data.MemberOf), data.UserObjectID.Value))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
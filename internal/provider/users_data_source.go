@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-msgraph/internal/msgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *msgraph.Client
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	Id                 types.String         `tfsdk:"id"`
+	Filter             types.String         `tfsdk:"filter"`
+	Search             types.String         `tfsdk:"search"`
+	Select             types.List           `tfsdk:"select"`
+	MaxResults         types.Int64          `tfsdk:"max_results"`
+	ObjectIDs          types.List           `tfsdk:"object_ids"`
+	UserPrincipalNames types.List           `tfsdk:"user_principal_names"`
+	Users              []UsersDataUserModel `tfsdk:"users"`
+}
+
+// UsersDataUserModel describes a user returned by the data source.
+type UsersDataUserModel struct {
+	ObjectID          types.String `tfsdk:"object_id"`
+	UserPrincipalName types.String `tfsdk:"user_principal_name"`
+	Mail              types.String `tfsdk:"mail"`
+	DisplayName       types.String `tfsdk:"display_name"`
+	MailNickname      types.String `tfsdk:"mail_nickname"`
+	AccountEnabled    types.Bool   `tfsdk:"account_enabled"`
+	UserType          types.String `tfsdk:"user_type"`
+	GivenName         types.String `tfsdk:"given_name"`
+	Surname           types.String `tfsdk:"surname"`
+	UsageLocation     types.String `tfsdk:"usage_location"`
+	Department        types.String `tfsdk:"department"`
+	JobTitle          types.String `tfsdk:"job_title"`
+	CompanyName       types.String `tfsdk:"company_name"`
+	OfficeLocation    types.String `tfsdk:"office_location"`
+	MobilePhone       types.String `tfsdk:"mobile_phone"`
+	EmployeeID        types.String `tfsdk:"employee_id"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	userAttributes := userComputedAttributes()
+	userAttributes["object_id"] = tfsdk.Attribute{
+		MarkdownDescription: "User Object ID",
+		Computed:            true,
+		Type:                types.StringType,
+	}
+	userAttributes["user_principal_name"] = tfsdk.Attribute{
+		MarkdownDescription: "User principal name",
+		Computed:            true,
+		Type:                types.StringType,
+	}
+	userAttributes["mail"] = tfsdk.Attribute{
+		MarkdownDescription: "Primary SMTP address",
+		Computed:            true,
+		Type:                types.StringType,
+	}
+
+	return tfsdk.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Users data source, lists the users matching an OData filter or search using advanced queries",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "identifier",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"filter": {
+				MarkdownDescription: "OData filter, e.g. `department eq 'Platform'`",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"search": {
+				MarkdownDescription: "OData search, e.g. `\"displayName:jane\"`",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"select": {
+				MarkdownDescription: "User properties to read, the ID and user principal name are always read. Unselected properties are null",
+				Optional:            true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"max_results": {
+				MarkdownDescription: "Maximum number of users to return, all matching users are returned when not set",
+				Optional:            true,
+				Type:                types.Int64Type,
+			},
+			"object_ids": {
+				MarkdownDescription: "Object IDs of the matching users",
+				Computed:            true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"user_principal_names": {
+				MarkdownDescription: "User principal names of the matching users",
+				Computed:            true,
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+			},
+			"users": {
+				MarkdownDescription: "Matching users",
+				Computed:            true,
+				Attributes:          tfsdk.ListNestedAttributes(userAttributes),
+			},
+		},
+	}, nil
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*msgraph.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *msgraph.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selected, diags := listToStrings(ctx, data.Select)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MaxResults.Value < 0 {
+		resp.Diagnostics.AddError("Invalid Configuration", "max_results cannot be negative.")
+		return
+	}
+
+	query := msgraph.CollectionQuery{
+		Filter:     data.Filter.Value,
+		Search:     data.Search.Value,
+		Select:     selected,
+		MaxResults: int(data.MaxResults.Value),
+	}
+
+	d.client.GraphAccess()
+	users, err := d.client.ListUsers(query)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
+		return
+	}
+
+	// accountEnabled is a plain bool in the graph api model, it reads as
+	// false when it was not selected.
+	accountEnabledSelected := len(selected) == 0
+	for i := 0; i < len(selected); i++ {
+		if strings.EqualFold(selected[i], "accountEnabled") {
+			accountEnabledSelected = true
+		}
+	}
+
+	objectIDs := make([]string, 0)
+	userPrincipalNames := make([]string, 0)
+	data.Users = make([]UsersDataUserModel, 0)
+	for i := 0; i < len(users); i++ {
+		user := users[i]
+		objectIDs = append(objectIDs, user.ID)
+		userPrincipalNames = append(userPrincipalNames, user.UserPrincipalName)
+		accountEnabled := types.Bool{Null: true}
+		if accountEnabledSelected {
+			accountEnabled = types.Bool{Value: user.AccountEnabled}
+		}
+		data.Users = append(data.Users, UsersDataUserModel{
+			ObjectID:          types.String{Value: user.ID},
+			UserPrincipalName: types.String{Value: user.UserPrincipalName},
+			Mail:              optionalString(user.Mail),
+			DisplayName:       optionalString(user.DisplayName),
+			MailNickname:      optionalString(user.MailNickname),
+			AccountEnabled:    accountEnabled,
+			UserType:          optionalString(user.UserType),
+			GivenName:         optionalStringPointer(user.GivenName),
+			Surname:           optionalStringPointer(user.Surname),
+			UsageLocation:     optionalStringPointer(user.UsageLocation),
+			Department:        optionalStringPointer(user.Department),
+			JobTitle:          optionalStringPointer(user.JobTitle),
+			CompanyName:       optionalStringPointer(user.CompanyName),
+			OfficeLocation:    optionalStringPointer(user.OfficeLocation),
+			MobilePhone:       optionalStringPointer(user.MobilePhone),
+			EmployeeID:        optionalStringPointer(user.EmployeeID),
+		})
+	}
+
+	data.Id = types.String{Value: "users"}
+	data.ObjectIDs = stringsToList(objectIDs)
+	data.UserPrincipalNames = stringsToList(userPrincipalNames)
+
+	tflog.Trace(ctx, fmt.Sprintf("listed %d users", len(users)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.AttributeValidator = stringOneOfValidator{}
+
+// stringOneOfValidator validates that a string attribute is one of the
+// accepted values.
+type stringOneOfValidator struct {
+	values []string
+}
+
+func stringOneOf(values ...string) stringOneOfValidator {
+	return stringOneOfValidator{values: values}
+}
+
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var value types.String
+	resp.Diagnostics.Append(tfsdk.ValueAs(ctx, req.AttributeConfig, &value)...)
+	if resp.Diagnostics.HasError() || value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	if !containsString(v.values, value.Value) {
+		resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Attribute Value", fmt.Sprintf("%q is not valid, %s.", value.Value, v.Description(ctx)))
+	}
+}
+
+var _ tfsdk.AttributeValidator = stringSetMatchesValidator{}
+
+// stringSetMatchesValidator validates that every element of a set of
+// strings matches a regular expression.
+type stringSetMatchesValidator struct {
+	pattern     *regexp.Regexp
+	description string
+}
+
+func stringSetMatches(pattern *regexp.Regexp, description string) stringSetMatchesValidator {
+	return stringSetMatchesValidator{pattern: pattern, description: description}
+}
+
+func (v stringSetMatchesValidator) Description(ctx context.Context) string {
+	return v.description
+}
+
+func (v stringSetMatchesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringSetMatchesValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var set types.Set
+	resp.Diagnostics.Append(tfsdk.ValueAs(ctx, req.AttributeConfig, &set)...)
+	if resp.Diagnostics.HasError() || set.IsNull() || set.IsUnknown() {
+		return
+	}
+
+	for _, elem := range set.Elems {
+		value, ok := elem.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if !v.pattern.MatchString(value.Value) {
+			resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Attribute Value", fmt.Sprintf("%q is not valid, %s.", value.Value, v.description))
+		}
+	}
+}
+
+var _ tfsdk.AttributeValidator = combinationSetValidator{}
+
+// combinationSetValidator validates that every element of a set of strings
+// is a comma separated combination of the accepted values, e.g.
+// password,sms.
+type combinationSetValidator struct {
+	values []string
+}
+
+func combinationSetOf(values ...string) combinationSetValidator {
+	return combinationSetValidator{values: values}
+}
+
+func (v combinationSetValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("values must be comma separated combinations of: %s", strings.Join(v.values, ", "))
+}
+
+func (v combinationSetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v combinationSetValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var set types.Set
+	resp.Diagnostics.Append(tfsdk.ValueAs(ctx, req.AttributeConfig, &set)...)
+	if resp.Diagnostics.HasError() || set.IsNull() || set.IsUnknown() {
+		return
+	}
+
+	for _, elem := range set.Elems {
+		value, ok := elem.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		for _, part := range strings.Split(value.Value, ",") {
+			if !containsString(v.values, part) {
+				resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Attribute Value", fmt.Sprintf("%q is not valid, %q is not one of: %s.", value.Value, part, strings.Join(v.values, ", ")))
+				break
+			}
+		}
+	}
+}
+
+var _ tfsdk.AttributeValidator = listNotEmptyValidator{}
+
+// listNotEmptyValidator validates that a list attribute is either not set
+// or has at least one element.
+type listNotEmptyValidator struct{}
+
+func listNotEmpty() listNotEmptyValidator {
+	return listNotEmptyValidator{}
+}
+
+func (v listNotEmptyValidator) Description(ctx context.Context) string {
+	return "list must not be empty, omit the attribute instead"
+}
+
+func (v listNotEmptyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v listNotEmptyValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var list types.List
+	resp.Diagnostics.Append(tfsdk.ValueAs(ctx, req.AttributeConfig, &list)...)
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+
+	if len(list.Elems) == 0 {
+		resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Attribute Value", fmt.Sprintf("The %s.", v.Description(ctx)))
+	}
+}
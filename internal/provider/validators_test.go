@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCombinationSetOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.Set
+		wantErr bool
+	}{
+		{name: "null", value: types.Set{Null: true, ElemType: types.StringType}},
+		{name: "unknown", value: types.Set{Unknown: true, ElemType: types.StringType}},
+		{name: "single value", value: stringsToSet([]string{"password"})},
+		{name: "combination", value: stringsToSet([]string{"password,sms", "fido2"})},
+		{name: "unknown element", value: types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Unknown: true}}}},
+		{name: "invalid value", value: stringsToSet([]string{"password", "email"}), wantErr: true},
+		{name: "invalid part", value: stringsToSet([]string{"password,email"}), wantErr: true},
+		{name: "empty part", value: stringsToSet([]string{"password,"}), wantErr: true},
+		{name: "space after comma", value: stringsToSet([]string{"password, sms"}), wantErr: true},
+		{name: "wrong case", value: stringsToSet([]string{"Password"}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("combinations"),
+				AttributeConfig: tt.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+			combinationSetOf("password", "sms", "fido2").Validate(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("got errors %v, want error %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}